@@ -1,11 +1,11 @@
 package cache
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
-	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/go-redis/redis"
 )
@@ -15,40 +15,73 @@ type chapterCache struct {
 }
 
 type ChapterCache interface {
-	Set(titleID, value string) error
-	Get(titleID string) (string, error)
-	Delete(titleID string) error
+	Set(ctx context.Context, titleID, value string, ttl config.CacheTTL) error
+	Get(ctx context.Context, titleID string) (string, error)
+	GetEntry(ctx context.Context, titleID string) (Entry, error)
+	LockRefresh(ctx context.Context, titleID string) (bool, error)
+	Delete(ctx context.Context, titleID string) error
+	Key(titleID string) string
 }
 
 func generateChapterCacheKey(titleID string) string {
 	return fmt.Sprintf("ChaptersCache|%s", titleID)
 }
 
-func (c *chapterCache) Set(titleID, value string) error {
+func (c *chapterCache) Set(ctx context.Context, titleID, value string, ttl config.CacheTTL) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	key := generateChapterCacheKey(titleID)
-	err := c.redisClient.Set(key, value, time.Duration(constants.ChapterCacheExpirationInMn)*time.Minute).Err()
+	err := setEntry(ctx, c.redisClient, key, value, ttl)
 	if err != nil {
 		logger.Errorf("Failed to set %s - %s", key, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, key)
+	return nil
 }
 
-func (c *chapterCache) Get(titleID string) (string, error) {
+func (c *chapterCache) Get(ctx context.Context, titleID string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	key := generateChapterCacheKey(titleID)
-	value, err := c.redisClient.Get(key).Result()
+	value, err := c.redisClient.WithContext(ctx).Get(key).Result()
 	if err != nil {
 		logger.Errorf("Failed to get %s - %s", key, err)
 	}
 	return value, err
 }
 
-func (c *chapterCache) Delete(titleID string) error {
+func (c *chapterCache) GetEntry(ctx context.Context, titleID string) (Entry, error) {
+	return getEntry(ctx, c.redisClient, generateChapterCacheKey(titleID))
+}
+
+func (c *chapterCache) LockRefresh(ctx context.Context, titleID string) (bool, error) {
+	return lockRefresh(ctx, c.redisClient, generateChapterCacheKey(titleID))
+}
+
+func (c *chapterCache) Delete(ctx context.Context, titleID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	key := generateChapterCacheKey(titleID)
-	err := c.redisClient.Del(key).Err()
+	err := deleteEntry(ctx, c.redisClient, key)
 	if err != nil {
 		logger.Errorf("Failed to delete %s - %s", key, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, key)
+	return nil
+}
+
+func (c *chapterCache) Key(titleID string) string {
+	return generateChapterCacheKey(titleID)
 }
 
 func NewChapterCache() *chapterCache {
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -39,17 +40,18 @@ const (
 
 func (s *ChapterCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
 	value := "lorem ipsum"
-	err := s.c.Set(chapterTitleID, value)
+	err := s.c.Set(context.Background(), chapterTitleID, value, config.CacheTTL{Expiration: time.Minute, HardExpiration: time.Hour})
 	assert.Nil(s.T(), err)
 
 	result, _ := s.c.redisClient.Get(s.k).Result()
 	assert.Equal(s.T(), value, result)
+	assert.Equal(s.T(), time.Hour, s.c.redisClient.PTTL(s.k).Val())
 
-	s.c.redisClient.Del(s.k)
+	_ = s.c.Delete(context.Background(), chapterTitleID)
 }
 
 func (s *ChapterCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
-	val, err := s.c.Get(chapterTitleID)
+	val, err := s.c.Get(context.Background(), chapterTitleID)
 
 	assert.Equal(s.T(), "", val)
 	assert.Equal(s.T(), "redis: nil", err.Error())
@@ -57,7 +59,7 @@ func (s *ChapterCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
 
 func (s *ChapterCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 	s.c.redisClient.Set(s.k, "lorem ipsum", 5*time.Second)
-	val, err := s.c.Get(chapterTitleID)
+	val, err := s.c.Get(context.Background(), chapterTitleID)
 
 	assert.Equal(s.T(), "lorem ipsum", val)
 	assert.Nil(s.T(), err)
@@ -66,14 +68,14 @@ func (s *ChapterCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 }
 
 func (s *ChapterCacheTestSuite) TestDelete_WhenKeyIsMissing() {
-	err := s.c.Delete(chapterTitleID)
+	err := s.c.Delete(context.Background(), chapterTitleID)
 
 	assert.Nil(s.T(), err)
 }
 
 func (s *ChapterCacheTestSuite) TestDelete_WhenKeyExists() {
 	s.c.redisClient.Set(s.k, "lorem ipsum", 5*time.Second)
-	err := s.c.Delete(chapterTitleID)
+	err := s.c.Delete(context.Background(), chapterTitleID)
 	val, _ := s.c.redisClient.Get(s.k).Result()
 
 	assert.Nil(s.T(), err)
@@ -0,0 +1,162 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/klauspost/compress/zstd"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Encoded values start with a header byte made of headerFlag, the format in
+// the middle bits and the compression in the low bits. JSON never starts with
+// a byte this high, so values without a header are read as plain JSON.
+const (
+	headerFlag byte = 0x80
+
+	headerFormatJSON    byte = 0x00
+	headerFormatMsgpack byte = 0x20
+
+	headerCompressionNone byte = 0x00
+	headerCompressionGzip byte = 0x01
+	headerCompressionZstd byte = 0x03
+
+	headerFormatMask      byte = 0x70
+	headerCompressionMask byte = 0x0f
+)
+
+var headerFormats = map[string]byte{
+	constants.CacheFormatJSON:    headerFormatJSON,
+	constants.CacheFormatMsgpack: headerFormatMsgpack,
+}
+
+var headerCompressions = map[string]byte{
+	constants.CacheCompressionNone: headerCompressionNone,
+	constants.CacheCompressionGzip: headerCompressionGzip,
+	constants.CacheCompressionZstd: headerCompressionZstd,
+}
+
+// Codec turns the decoded cache values into the strings stored in Redis and
+// back. Decode reads every known encoding, whatever the codec writes.
+type Codec interface {
+	Encode(v interface{}) (string, error)
+	Decode(data string, v interface{}) error
+}
+
+type codec struct {
+	header byte
+}
+
+func (c *codec) Encode(v interface{}) (string, error) {
+	// Plain JSON is written without a header, like before codecs existed, so
+	// it stays readable by older processes.
+	if c.header == headerFlag|headerFormatJSON|headerCompressionNone {
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteByte(c.header)
+
+	w, err := newCompressionWriter(buf, c.header&headerCompressionMask)
+	if err != nil {
+		return "", err
+	}
+
+	if c.header&headerFormatMask == headerFormatMsgpack {
+		enc := msgpack.NewEncoder(w)
+		enc.SetCustomStructTag("json")
+		err = enc.Encode(v)
+	} else {
+		err = json.NewEncoder(w).Encode(v)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func (c *codec) Decode(data string, v interface{}) error {
+	if data == "" {
+		return errors.New("empty cache value")
+	}
+
+	header := data[0]
+	if header&headerFlag == 0 {
+		return json.Unmarshal([]byte(data), v)
+	}
+
+	r, err := newCompressionReader(bytes.NewReader([]byte(data[1:])), header&headerCompressionMask)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	switch header & headerFormatMask {
+	case headerFormatJSON:
+		return json.NewDecoder(r).Decode(v)
+	case headerFormatMsgpack:
+		dec := msgpack.NewDecoder(r)
+		dec.SetCustomStructTag("json")
+		return dec.Decode(v)
+	default:
+		return fmt.Errorf("unknown cache value format: %#x", header)
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+func newCompressionWriter(w io.Writer, compression byte) (io.WriteCloser, error) {
+	switch compression {
+	case headerCompressionNone:
+		return nopWriteCloser{w}, nil
+	case headerCompressionGzip:
+		return gzip.NewWriter(w), nil
+	case headerCompressionZstd:
+		return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	default:
+		return nil, fmt.Errorf("unknown cache value compression: %#x", compression)
+	}
+}
+
+func newCompressionReader(r io.Reader, compression byte) (io.ReadCloser, error) {
+	switch compression {
+	case headerCompressionNone:
+		return ioutil.NopCloser(r), nil
+	case headerCompressionGzip:
+		return gzip.NewReader(r)
+	case headerCompressionZstd:
+		d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unknown cache value compression: %#x", compression)
+	}
+}
+
+// NewCodec returns the codec of the configured format and compression.
+func NewCodec() *codec {
+	cfg := config.CacheCodec()
+	return &codec{
+		header: headerFlag | headerFormats[cfg.Format] | headerCompressions[cfg.Compression],
+	}
+}
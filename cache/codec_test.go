@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCodecTestSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (s *CodecTestSuite) SetupSuite() {
+	config.Load()
+}
+
+func getFakeContentList() *domain.ContentListResponse {
+	cl := &domain.ContentListResponse{}
+	for i := 1; i <= 40; i++ {
+		cl.Contents = append(cl.Contents, domain.Content{
+			ImageURL: fmt.Sprintf("http://foo.com/mangas/bleach/686/bleach_686_%02d.jpg", i),
+			Page:     i,
+		})
+	}
+	return cl
+}
+
+func (s *CodecTestSuite) TestEncode_WritesPlainJSON_WhenCodecIsJSONWithoutCompression() {
+	cl := getFakeContentList()
+	value, err := NewCodec().Encode(cl)
+
+	expected, _ := json.Marshal(cl)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), string(expected), value)
+}
+
+func (s *CodecTestSuite) TestDecode_ReadsEveryEncoding() {
+	cl := getFakeContentList()
+	plain, _ := json.Marshal(cl)
+
+	for format := range headerFormats {
+		for compression := range headerCompressions {
+			c := &codec{header: headerFlag | headerFormats[format] | headerCompressions[compression]}
+			value, err := c.Encode(cl)
+			assert.Nil(s.T(), err)
+
+			var res *domain.ContentListResponse
+			err = NewCodec().Decode(value, &res)
+
+			assert.Nil(s.T(), err, format+"/"+compression)
+			assert.Equal(s.T(), cl, res, format+"/"+compression)
+			if compression != constants.CacheCompressionNone {
+				assert.True(s.T(), len(value) < len(plain), format+"/"+compression)
+			}
+		}
+	}
+}
+
+func (s *CodecTestSuite) TestDecode_ReadsLegacyJSON_WhenCodecIsCompressed() {
+	format := os.Getenv("CACHE_CODEC_FORMAT")
+	compression := os.Getenv("CACHE_CODEC_COMPRESSION")
+	_ = os.Setenv("CACHE_CODEC_FORMAT", constants.CacheFormatMsgpack)
+	_ = os.Setenv("CACHE_CODEC_COMPRESSION", constants.CacheCompressionGzip)
+	config.Load()
+	defer func() {
+		_ = os.Setenv("CACHE_CODEC_FORMAT", format)
+		_ = os.Setenv("CACHE_CODEC_COMPRESSION", compression)
+		config.Load()
+	}()
+
+	cl := getFakeContentList()
+	plain, _ := json.Marshal(cl)
+
+	var res *domain.ContentListResponse
+	err := NewCodec().Decode(string(plain), &res)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), cl, res)
+}
+
+func (s *CodecTestSuite) TestDecode_ReturnsError_WhenHeaderIsUnknown() {
+	var res *domain.ContentListResponse
+
+	assert.NotNil(s.T(), NewCodec().Decode(string([]byte{0x82, '{', '}'}), &res))
+	assert.NotNil(s.T(), NewCodec().Decode(string([]byte{0xf0, '{', '}'}), &res))
+	assert.NotNil(s.T(), NewCodec().Decode(string([]byte{0x90, '{', '}'}), &res))
+	assert.NotNil(s.T(), NewCodec().Decode("", &res))
+}
@@ -1,11 +1,11 @@
 package cache
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
-	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/go-redis/redis"
 )
@@ -15,40 +15,73 @@ type contentCache struct {
 }
 
 type ContentCache interface {
-	Set(titleID, chapter, value string) error
-	Get(titleID, chapter string) (string, error)
-	Delete(titleID, chapter string) error
+	Set(ctx context.Context, titleID, chapter, value string) error
+	Get(ctx context.Context, titleID, chapter string) (string, error)
+	GetEntry(ctx context.Context, titleID, chapter string) (Entry, error)
+	LockRefresh(ctx context.Context, titleID, chapter string) (bool, error)
+	Delete(ctx context.Context, titleID, chapter string) error
+	Key(titleID, chapter string) string
 }
 
 func generateContentCacheKey(titleID, chapter string) string {
 	return fmt.Sprintf("ContentsCache|%s|%s", titleID, chapter)
 }
 
-func (c *contentCache) Set(titleID, chapter, value string) error {
+func (c *contentCache) Set(ctx context.Context, titleID, chapter, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	key := generateContentCacheKey(titleID, chapter)
-	err := c.redisClient.Set(key, value, time.Duration(constants.ContentCacheExpirationInMn)*time.Minute).Err()
+	err := setEntry(ctx, c.redisClient, key, value, config.CacheTTLs().Content)
 	if err != nil {
 		logger.Errorf("Failed to set %s - %s", key, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, key)
+	return nil
 }
 
-func (c *contentCache) Get(titleID, chapter string) (string, error) {
+func (c *contentCache) Get(ctx context.Context, titleID, chapter string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
 	key := generateContentCacheKey(titleID, chapter)
-	value, err := c.redisClient.Get(key).Result()
+	value, err := c.redisClient.WithContext(ctx).Get(key).Result()
 	if err != nil {
 		logger.Errorf("Failed to get %s - %s", key, err)
 	}
 	return value, err
 }
 
-func (c *contentCache) Delete(titleID, chapter string) error {
+func (c *contentCache) GetEntry(ctx context.Context, titleID, chapter string) (Entry, error) {
+	return getEntry(ctx, c.redisClient, generateContentCacheKey(titleID, chapter))
+}
+
+func (c *contentCache) LockRefresh(ctx context.Context, titleID, chapter string) (bool, error) {
+	return lockRefresh(ctx, c.redisClient, generateContentCacheKey(titleID, chapter))
+}
+
+func (c *contentCache) Delete(ctx context.Context, titleID, chapter string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	key := generateContentCacheKey(titleID, chapter)
-	err := c.redisClient.Del(key).Err()
+	err := deleteEntry(ctx, c.redisClient, key)
 	if err != nil {
 		logger.Errorf("Failed to delete %s - %s", key, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, key)
+	return nil
+}
+
+func (c *contentCache) Key(titleID, chapter string) string {
+	return generateContentCacheKey(titleID, chapter)
 }
 
 func NewContentCache() *contentCache {
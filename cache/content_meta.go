@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+type contentMetaCache struct {
+	redisClient *redis.Client
+}
+
+type ContentMetaCache interface {
+	Set(ctx context.Context, titleID, chapter, value string) error
+	Get(ctx context.Context, titleID, chapter string) (string, error)
+	Delete(ctx context.Context, titleID, chapter string) error
+}
+
+func generateContentMetaCacheKey(titleID, chapter string) string {
+	return fmt.Sprintf("ContentMetaCache|%s|%s", titleID, chapter)
+}
+
+func (c *contentMetaCache) Set(ctx context.Context, titleID, chapter, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	key := generateContentMetaCacheKey(titleID, chapter)
+	err := c.redisClient.WithContext(ctx).Set(key, value, config.CacheTTLs().Content.HardExpiration).Err()
+	if err != nil {
+		logger.Errorf("Failed to set %s - %s", key, err)
+	}
+	return err
+}
+
+func (c *contentMetaCache) Get(ctx context.Context, titleID, chapter string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	key := generateContentMetaCacheKey(titleID, chapter)
+	value, err := c.redisClient.WithContext(ctx).Get(key).Result()
+	if err != nil {
+		logger.Errorf("Failed to get %s - %s", key, err)
+	}
+	return value, err
+}
+
+func (c *contentMetaCache) Delete(ctx context.Context, titleID, chapter string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	key := generateContentMetaCacheKey(titleID, chapter)
+	err := c.redisClient.WithContext(ctx).Del(key).Err()
+	if err != nil {
+		logger.Errorf("Failed to delete %s - %s", key, err)
+	}
+	return err
+}
+
+func NewContentMetaCache() *contentMetaCache {
+	return &contentMetaCache{
+		redisClient: appcontext.GetRedisClient(),
+	}
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContentMetaCacheTestSuite struct {
+	suite.Suite
+	c *contentMetaCache
+	k string
+}
+
+func (s *ContentMetaCacheTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+
+	s.k = generateContentMetaCacheKey(contentTitleID, contentChapter)
+}
+
+func (s *ContentMetaCacheTestSuite) SetupTest() {
+	s.c = NewContentMetaCache()
+}
+
+func TestContentMetaCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentMetaCacheTestSuite))
+}
+
+func (s *ContentMetaCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
+	value := "lorem ipsum"
+	err := s.c.Set(context.Background(), contentTitleID, contentChapter, value)
+	assert.Nil(s.T(), err)
+
+	result, _ := s.c.redisClient.Get(s.k).Result()
+	assert.Equal(s.T(), value, result)
+
+	s.c.redisClient.Del(s.k)
+}
+
+func (s *ContentMetaCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
+	val, err := s.c.Get(context.Background(), contentTitleID, contentChapter)
+
+	assert.Equal(s.T(), "", val)
+	assert.Equal(s.T(), "redis: nil", err.Error())
+}
+
+func (s *ContentMetaCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
+	s.c.redisClient.Set(s.k, "lorem ipsum", 5*time.Second)
+	val, err := s.c.Get(context.Background(), contentTitleID, contentChapter)
+
+	assert.Equal(s.T(), "lorem ipsum", val)
+	assert.Nil(s.T(), err)
+
+	s.c.redisClient.Del(s.k)
+}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -40,7 +41,7 @@ const (
 
 func (s *ContentCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
 	value := "lorem ipsum"
-	err := s.c.Set(contentTitleID, contentChapter, value)
+	err := s.c.Set(context.Background(), contentTitleID, contentChapter, value)
 	assert.Nil(s.T(), err)
 
 	result, _ := s.c.redisClient.Get(s.k).Result()
@@ -50,7 +51,7 @@ func (s *ContentCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
 }
 
 func (s *ContentCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
-	val, err := s.c.Get(contentTitleID, contentChapter)
+	val, err := s.c.Get(context.Background(), contentTitleID, contentChapter)
 
 	assert.Equal(s.T(), "", val)
 	assert.Equal(s.T(), "redis: nil", err.Error())
@@ -58,7 +59,7 @@ func (s *ContentCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
 
 func (s *ContentCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 	s.c.redisClient.Set(s.k, "lorem ipsum", 5*time.Second)
-	val, err := s.c.Get(contentTitleID, contentChapter)
+	val, err := s.c.Get(context.Background(), contentTitleID, contentChapter)
 
 	assert.Equal(s.T(), "lorem ipsum", val)
 	assert.Nil(s.T(), err)
@@ -67,14 +68,14 @@ func (s *ContentCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 }
 
 func (s *ContentCacheTestSuite) TestDelete_WhenKeyIsMissing() {
-	err := s.c.Delete(contentTitleID, contentChapter)
+	err := s.c.Delete(context.Background(), contentTitleID, contentChapter)
 
 	assert.Nil(s.T(), err)
 }
 
 func (s *ContentCacheTestSuite) TestDelete_WhenKeyExists() {
 	s.c.redisClient.Set(s.k, "lorem ipsum", 5*time.Second)
-	err := s.c.Delete(contentTitleID, contentChapter)
+	err := s.c.Delete(context.Background(), contentTitleID, contentChapter)
 	val, _ := s.c.redisClient.Get(s.k).Result()
 
 	assert.Nil(s.T(), err)
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+// Entry is a cached value along with the time it has left before its hard
+// expiration, and the times it was written and goes stale. Those are zero
+// for values written before the times were stored.
+type Entry struct {
+	Value    string
+	TTL      time.Duration
+	StoredAt time.Time
+	StaleAt  time.Time
+}
+
+// entryTimes is kept in a sibling key of the value, so the freshness of an
+// entry is the one of the policy it was written with.
+type entryTimes struct {
+	StoredAt time.Time `json:"stored_at"`
+	StaleAt  time.Time `json:"stale_at"`
+}
+
+func generateEntryTimesKey(key string) string {
+	return "CacheTimes|" + key
+}
+
+func setEntry(ctx context.Context, redisClient *redis.Client, key, value string, ttl config.CacheTTL) error {
+	now := time.Now()
+	times, err := json.Marshal(entryTimes{StoredAt: now, StaleAt: now.Add(ttl.Expiration)})
+	if err != nil {
+		return err
+	}
+
+	_, err = redisClient.WithContext(ctx).TxPipelined(func(p redis.Pipeliner) error {
+		p.Set(key, value, ttl.HardExpiration)
+		p.Set(generateEntryTimesKey(key), times, ttl.HardExpiration)
+		return nil
+	})
+	return err
+}
+
+func getEntry(ctx context.Context, redisClient *redis.Client, key string) (Entry, error) {
+	if err := ctx.Err(); err != nil {
+		return Entry{}, err
+	}
+
+	var get, getTimes *redis.StringCmd
+	var pttl *redis.DurationCmd
+	_, err := redisClient.WithContext(ctx).Pipelined(func(p redis.Pipeliner) error {
+		get = p.Get(key)
+		pttl = p.PTTL(key)
+		getTimes = p.Get(generateEntryTimesKey(key))
+		return nil
+	})
+	if err == redis.Nil {
+		err = get.Err()
+	}
+	if err != nil {
+		logger.Errorf("Failed to get %s - %s", key, err)
+		return Entry{}, err
+	}
+
+	entry := Entry{Value: get.Val(), TTL: pttl.Val()}
+	var times entryTimes
+	if json.Unmarshal([]byte(getTimes.Val()), &times) == nil {
+		entry.StoredAt = times.StoredAt
+		entry.StaleAt = times.StaleAt
+	}
+	return entry, nil
+}
+
+func deleteEntry(ctx context.Context, redisClient *redis.Client, key string) error {
+	return redisClient.WithContext(ctx).Del(key, generateEntryTimesKey(key)).Err()
+}
+
+// lockRefresh lets a single caller refresh a stale key until the lock
+// expires, so a burst of stale reads enqueues one refresh job.
+func lockRefresh(ctx context.Context, redisClient *redis.Client, key string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	lockKey := "RefreshLock|" + key
+	ok, err := redisClient.WithContext(ctx).SetNX(lockKey, 1, time.Duration(constants.CacheRefreshLockExpirationInSec)*time.Second).Result()
+	if err != nil {
+		logger.Errorf("Failed to lock %s - %s", lockKey, err)
+	}
+	return ok, err
+}
@@ -0,0 +1,226 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+// imageCache keeps proxied origin images on the local disk, one file per
+// image path, and treats files older than ttl as missing. Once the files
+// take more than maxSize bytes, the oldest ones are evicted.
+type imageCache struct {
+	dir     string
+	ttl     time.Duration
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	scanned bool
+}
+
+type ImageCache interface {
+	Create(ctx context.Context, path string) (ImageWriter, error)
+	Get(ctx context.Context, path string) (*os.File, error)
+	Delete(ctx context.Context, path string) error
+}
+
+// ImageWriter writes an image to a temporary file, so readers never see
+// partial images. Commit moves the file into the cache once the image is
+// complete, and Close drops it unless it was committed.
+type ImageWriter interface {
+	io.Writer
+	Commit() error
+	Close() error
+}
+
+type imageWriter struct {
+	cache *imageCache
+	key   string
+	tmp   *os.File
+	size  int64
+	done  bool
+}
+
+func generateImageCacheKey(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return hex.EncodeToString(sum[:]) + strings.ToLower(filepath.Ext(path))
+}
+
+func (c *imageCache) Create(ctx context.Context, path string) (ImageWriter, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := generateImageCacheKey(path)
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		logger.Errorf("Failed to set image %s - %s", key, err)
+		return nil, err
+	}
+
+	tmp, err := ioutil.TempFile(c.dir, key+".*.tmp")
+	if err != nil {
+		logger.Errorf("Failed to set image %s - %s", key, err)
+		return nil, err
+	}
+	return &imageWriter{cache: c, key: key, tmp: tmp}, nil
+}
+
+func (w *imageWriter) Write(p []byte) (int, error) {
+	n, err := w.tmp.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *imageWriter) Commit() error {
+	if w.done {
+		return errors.New("image writer is closed")
+	}
+	w.done = true
+	defer os.Remove(w.tmp.Name())
+
+	err := w.tmp.Close()
+	if err == nil {
+		err = os.Rename(w.tmp.Name(), filepath.Join(w.cache.dir, w.key))
+	}
+	if err != nil {
+		logger.Errorf("Failed to set image %s - %s", w.key, err)
+		return err
+	}
+
+	w.cache.track(w.size)
+	return nil
+}
+
+func (w *imageWriter) Close() error {
+	if w.done {
+		return nil
+	}
+	w.done = true
+
+	_ = w.tmp.Close()
+	return os.Remove(w.tmp.Name())
+}
+
+// track adds n written bytes to the cache size, and evicts files once the
+// size goes over the limit. The size is only counted from the files on disk
+// on the first write and on evictions, so it may overestimate in between.
+func (c *imageCache) track(n int64) {
+	if c.maxSize <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size += n
+	if !c.scanned || c.size > c.maxSize {
+		c.size = c.evict()
+		c.scanned = true
+	}
+}
+
+// evict removes expired files, then the oldest files until the cache is
+// below 90% of its limit, so the next writes do not evict again right away.
+// It returns the size of the files left.
+func (c *imageCache) evict() int64 {
+	fis, err := ioutil.ReadDir(c.dir)
+	if err != nil {
+		logger.Errorf("Failed to read image cache %s - %s", c.dir, err)
+		return 0
+	}
+
+	var files []os.FileInfo
+	var size int64
+	for _, fi := range fis {
+		if fi.IsDir() || strings.HasSuffix(fi.Name(), ".tmp") {
+			continue
+		}
+		if c.isExpired(fi) {
+			c.remove(fi.Name())
+			continue
+		}
+		files = append(files, fi)
+		size += fi.Size()
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	target := c.maxSize / 10 * 9
+	for i := 0; size > target && i < len(files); i++ {
+		c.remove(files[i].Name())
+		size -= files[i].Size()
+	}
+	return size
+}
+
+func (c *imageCache) isExpired(fi os.FileInfo) bool {
+	return c.ttl > 0 && time.Since(fi.ModTime()) > c.ttl
+}
+
+func (c *imageCache) remove(key string) {
+	err := os.Remove(filepath.Join(c.dir, key))
+	if err != nil && !os.IsNotExist(err) {
+		logger.Errorf("Failed to delete image %s - %s", key, err)
+	}
+}
+
+func (c *imageCache) Get(ctx context.Context, path string) (*os.File, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	key := generateImageCacheKey(path)
+	f, err := os.Open(filepath.Join(c.dir, key))
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if c.isExpired(fi) {
+		f.Close()
+		c.remove(key)
+		return nil, errors.New("image cache expired")
+	}
+	return f, nil
+}
+
+func (c *imageCache) Delete(ctx context.Context, path string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	key := generateImageCacheKey(path)
+	err := os.Remove(filepath.Join(c.dir, key))
+	if err != nil && !os.IsNotExist(err) {
+		logger.Errorf("Failed to delete image %s - %s", key, err)
+		return err
+	}
+	return nil
+}
+
+func NewImageCache() *imageCache {
+	return &imageCache{
+		dir:     config.ImageProxy().CacheDir,
+		ttl:     config.ImageProxy().CacheTTL,
+		maxSize: config.ImageProxy().CacheMaxSize,
+	}
+}
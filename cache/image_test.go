@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageCacheTestSuite struct {
+	suite.Suite
+	c *imageCache
+}
+
+func (s *ImageCacheTestSuite) SetupSuite() {
+	config.Load()
+	logger.SetupLogger()
+}
+
+func (s *ImageCacheTestSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "image-cache")
+	s.Require().NoError(err)
+
+	s.c = NewImageCache()
+	s.c.dir = filepath.Join(dir, "images")
+}
+
+func (s *ImageCacheTestSuite) TearDownTest() {
+	_ = os.RemoveAll(filepath.Dir(s.c.dir))
+}
+
+func TestImageCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageCacheTestSuite))
+}
+
+const imagePath = "mangas/bleach/686/01.JPG"
+
+func (s *ImageCacheTestSuite) setImage(path, data string) error {
+	w, err := s.c.Create(context.Background(), path)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	if _, err := w.Write([]byte(data)); err != nil {
+		return err
+	}
+	return w.Commit()
+}
+
+func (s *ImageCacheTestSuite) TestGenerateImageCacheKey_KeepsExtension() {
+	key := generateImageCacheKey(imagePath)
+
+	assert.Equal(s.T(), ".jpg", filepath.Ext(key))
+	assert.NotEqual(s.T(), generateImageCacheKey("mangas/bleach/686/02.JPG"), key)
+}
+
+func (s *ImageCacheTestSuite) TestCreateAndGet_ReturnsCommittedImage() {
+	err := s.setImage(imagePath, "foo")
+	assert.Nil(s.T(), err)
+
+	f, err := s.c.Get(context.Background(), imagePath)
+	assert.Nil(s.T(), err)
+	defer f.Close()
+
+	b, _ := ioutil.ReadAll(f)
+	assert.Equal(s.T(), "foo", string(b))
+}
+
+func (s *ImageCacheTestSuite) TestClose_DropsImage_WhenNotCommitted() {
+	w, err := s.c.Create(context.Background(), imagePath)
+	s.Require().NoError(err)
+
+	_, _ = w.Write([]byte("fo"))
+	assert.Nil(s.T(), w.Close())
+
+	f, err := s.c.Get(context.Background(), imagePath)
+	assert.Nil(s.T(), f)
+	assert.NotNil(s.T(), err)
+
+	fis, _ := ioutil.ReadDir(s.c.dir)
+	assert.Empty(s.T(), fis)
+}
+
+func (s *ImageCacheTestSuite) TestGet_ReturnsError_WhenImageIsMissing() {
+	f, err := s.c.Get(context.Background(), imagePath)
+
+	assert.Nil(s.T(), f)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ImageCacheTestSuite) TestGet_ReturnsError_WhenImageIsExpired() {
+	_ = s.setImage(imagePath, "foo")
+
+	old := time.Now().Add(-s.c.ttl - time.Minute)
+	_ = os.Chtimes(filepath.Join(s.c.dir, generateImageCacheKey(imagePath)), old, old)
+
+	f, err := s.c.Get(context.Background(), imagePath)
+
+	assert.Nil(s.T(), f)
+	assert.NotNil(s.T(), err)
+
+	_, err = os.Stat(filepath.Join(s.c.dir, generateImageCacheKey(imagePath)))
+	assert.True(s.T(), os.IsNotExist(err))
+}
+
+func (s *ImageCacheTestSuite) TestCommit_EvictsOldestImages_WhenCacheIsFull() {
+	s.c.maxSize = 10
+	paths := []string{"mangas/bleach/686/01.jpg", "mangas/bleach/686/02.jpg", "mangas/bleach/686/03.jpg"}
+
+	for i, p := range paths[:2] {
+		_ = s.setImage(p, "foo1")
+		old := time.Now().Add(time.Duration(i-2) * time.Minute)
+		_ = os.Chtimes(filepath.Join(s.c.dir, generateImageCacheKey(p)), old, old)
+	}
+	_ = s.setImage(paths[2], "foo3")
+
+	_, err := s.c.Get(context.Background(), paths[0])
+	assert.NotNil(s.T(), err)
+
+	for _, p := range paths[1:] {
+		f, err := s.c.Get(context.Background(), p)
+		assert.Nil(s.T(), err)
+		f.Close()
+	}
+	assert.Equal(s.T(), int64(8), s.c.size)
+}
+
+func (s *ImageCacheTestSuite) TestCommit_RemovesExpiredImages_WhenCacheIsFull() {
+	s.c.maxSize = 10
+	expiredPath := "mangas/bleach/686/02.jpg"
+
+	_ = s.setImage(expiredPath, "foo2")
+	old := time.Now().Add(-s.c.ttl - time.Minute)
+	_ = os.Chtimes(filepath.Join(s.c.dir, generateImageCacheKey(expiredPath)), old, old)
+
+	_ = s.setImage(imagePath, "foobar1")
+
+	_, err := os.Stat(filepath.Join(s.c.dir, generateImageCacheKey(expiredPath)))
+	assert.True(s.T(), os.IsNotExist(err))
+}
+
+func (s *ImageCacheTestSuite) TestDelete_RemovesStoredImage() {
+	_ = s.setImage(imagePath, "foo")
+
+	assert.Nil(s.T(), s.c.Delete(context.Background(), imagePath))
+	assert.Nil(s.T(), s.c.Delete(context.Background(), imagePath))
+
+	_, err := s.c.Get(context.Background(), imagePath)
+	assert.NotNil(s.T(), err)
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+const invalidationChannel = "CacheInvalidations"
+
+var localCaches struct {
+	sync.Mutex
+	caches []LocalCache
+}
+
+func registerLocalCache(c LocalCache) {
+	localCaches.Lock()
+	defer localCaches.Unlock()
+	localCaches.caches = append(localCaches.caches, c)
+}
+
+func invalidateLocalCaches(key string) {
+	localCaches.Lock()
+	defer localCaches.Unlock()
+	for _, c := range localCaches.caches {
+		c.Delete(key)
+	}
+}
+
+// publishInvalidation tells every process that key was written, so they drop
+// it from their local caches. A failed publish is only logged, the local
+// copies then live until their max age.
+func publishInvalidation(ctx context.Context, redisClient *redis.Client, key string) {
+	invalidateLocalCaches(key)
+
+	err := redisClient.WithContext(ctx).Publish(invalidationChannel, key).Err()
+	if err != nil {
+		logger.Errorf("Failed to publish invalidation of %s - %s", key, err)
+	}
+}
+
+// SubscribeInvalidations drops the keys written by any process from the local
+// caches of this one, until ctx is done. It blocks, so run it in a goroutine.
+func SubscribeInvalidations(ctx context.Context) {
+	ps := appcontext.GetRedisClient().Subscribe(invalidationChannel)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	ch := ps.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			invalidateLocalCaches(msg.Payload)
+		}
+	}
+}
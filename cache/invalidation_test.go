@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type InvalidationTestSuite struct {
+	suite.Suite
+}
+
+func (s *InvalidationTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestInvalidationTestSuite(t *testing.T) {
+	suite.Run(t, new(InvalidationTestSuite))
+}
+
+func (s *InvalidationTestSuite) TestSet_InvalidatesLocalCaches() {
+	lc := NewLocalCache(2, time.Minute)
+	lc.Set(mangaCacheKey, "foo", time.Hour, lc.Version())
+
+	mc := NewMangaCache()
+	_ = mc.Set(context.Background(), "bar")
+	defer func() {
+		_ = mc.Delete(context.Background())
+	}()
+
+	_, _, ok := lc.Get(mangaCacheKey)
+	assert.False(s.T(), ok)
+}
+
+func (s *InvalidationTestSuite) TestSubscribeInvalidations_EvictsKeysPublishedByOtherProcesses() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go SubscribeInvalidations(ctx)
+
+	lc := NewLocalCache(2, time.Minute)
+	redisClient := appcontext.GetRedisClient()
+
+	// The subscription starts asynchronously, so publish until it is heard.
+	evicted := false
+	for i := 0; i < 50 && !evicted; i++ {
+		lc.Set("foo", "bar", time.Hour, lc.Version())
+		redisClient.Publish(invalidationChannel, "foo")
+		time.Sleep(20 * time.Millisecond)
+		_, _, ok := lc.Get("foo")
+		evicted = !ok
+	}
+
+	assert.True(s.T(), evicted)
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LocalCache is an in-process LRU of decoded cache values, kept in front of
+// Redis so hot keys skip the round trip and the decoding. Values are
+// shared between callers and must not be modified.
+type LocalCache interface {
+	Get(key string) (value interface{}, ttl time.Duration, ok bool)
+	Set(key string, value interface{}, ttl time.Duration, version uint64)
+	Delete(key string)
+	Version() uint64
+}
+
+type localCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	maxAge     time.Duration
+	version    uint64
+	ll         *list.List
+	items      map[string]*list.Element
+}
+
+type localEntry struct {
+	key       string
+	value     interface{}
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+// Get returns the value of key along with the time it has left in Redis.
+// Values older than the max age are dropped, in case an invalidation was
+// missed.
+func (c *localCache) Get(key string) (interface{}, time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, 0, false
+	}
+
+	e := el.Value.(*localEntry)
+	now := time.Now()
+	if now.After(e.expiresAt) || now.Sub(e.storedAt) > c.maxAge {
+		c.remove(el)
+		return nil, 0, false
+	}
+
+	c.ll.MoveToFront(el)
+	return e.value, e.expiresAt.Sub(now), true
+}
+
+// Set stores value unless an invalidation came in after version was taken,
+// since the value may have been read from Redis before that write.
+func (c *localCache) Set(key string, value interface{}, ttl time.Duration, version uint64) {
+	if c.maxEntries <= 0 || ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if version != c.version {
+		return
+	}
+
+	now := time.Now()
+	e := &localEntry{key: key, value: value, storedAt: now, expiresAt: now.Add(ttl)}
+	if el, ok := c.items[key]; ok {
+		el.Value = e
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(e)
+	if c.ll.Len() > c.maxEntries {
+		c.remove(c.ll.Back())
+	}
+}
+
+func (c *localCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Version changes on every Delete. Take it before reading from Redis and
+// pass it to Set.
+func (c *localCache) Version() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.version
+}
+
+func (c *localCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*localEntry).key)
+}
+
+// NewLocalCache returns an LRU holding up to maxEntries values for at most
+// maxAge each, evicted by the invalidations of SubscribeInvalidations. It
+// stores nothing when maxEntries is zero.
+func NewLocalCache(maxEntries int, maxAge time.Duration) *localCache {
+	c := &localCache{
+		maxEntries: maxEntries,
+		maxAge:     maxAge,
+		ll:         list.New(),
+		items:      map[string]*list.Element{},
+	}
+	if maxEntries > 0 {
+		registerLocalCache(c)
+	}
+	return c
+}
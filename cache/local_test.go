@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type LocalCacheTestSuite struct {
+	suite.Suite
+}
+
+func TestLocalCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalCacheTestSuite))
+}
+
+func (s *LocalCacheTestSuite) TestGet_ReturnsValueAndTTL_WhenKeyIsStored() {
+	c := NewLocalCache(2, time.Minute)
+	c.Set("foo", "bar", time.Hour, c.Version())
+
+	v, ttl, ok := c.Get("foo")
+
+	assert.True(s.T(), ok)
+	assert.Equal(s.T(), "bar", v)
+	assert.True(s.T(), ttl > 59*time.Minute && ttl <= time.Hour)
+}
+
+func (s *LocalCacheTestSuite) TestSet_EvictsLeastRecentlyUsed_WhenFull() {
+	c := NewLocalCache(2, time.Minute)
+	c.Set("foo", 1, time.Hour, c.Version())
+	c.Set("bar", 2, time.Hour, c.Version())
+	_, _, _ = c.Get("foo")
+	c.Set("baz", 3, time.Hour, c.Version())
+
+	_, _, fooOK := c.Get("foo")
+	_, _, barOK := c.Get("bar")
+	_, _, bazOK := c.Get("baz")
+
+	assert.True(s.T(), fooOK)
+	assert.False(s.T(), barOK)
+	assert.True(s.T(), bazOK)
+}
+
+func (s *LocalCacheTestSuite) TestGet_Misses_WhenValueIsOlderThanMaxAge() {
+	c := NewLocalCache(2, time.Millisecond)
+	c.Set("foo", "bar", time.Hour, c.Version())
+	time.Sleep(5 * time.Millisecond)
+
+	_, _, ok := c.Get("foo")
+
+	assert.False(s.T(), ok)
+}
+
+func (s *LocalCacheTestSuite) TestSet_SkipsValue_WhenInvalidatedAfterVersionWasTaken() {
+	c := NewLocalCache(2, time.Minute)
+	version := c.Version()
+	c.Delete("foo")
+	c.Set("foo", "bar", time.Hour, version)
+
+	_, _, ok := c.Get("foo")
+
+	assert.False(s.T(), ok)
+}
+
+func (s *LocalCacheTestSuite) TestSet_StoresNothing_WhenDisabled() {
+	c := NewLocalCache(0, time.Minute)
+	c.Set("foo", "bar", time.Hour, c.Version())
+
+	_, _, ok := c.Get("foo")
+
+	assert.False(s.T(), ok)
+}
@@ -1,7 +1,7 @@
 package manager
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 
 	"github.com/bigscreen/mangindo-feeder/cache"
@@ -10,44 +10,94 @@ import (
 )
 
 type chapterCacheManager struct {
-	cClient client.ChapterClient
-	cCache  cache.ChapterCache
+	cClient       client.ChapterClient
+	mCacheManager MangaCacheManager
+	cCache        cache.ChapterCache
+	local         cache.LocalCache
+	codec         cache.Codec
 }
 
 type ChapterCacheManager interface {
-	SetCache(titleID string) error
-	GetCache(titleID string) (*domain.ChapterListResponse, error)
+	SetCache(ctx context.Context, titleID string) error
+	GetCache(ctx context.Context, titleID string) (*domain.ChapterListResponse, error)
+	GetCacheEntry(ctx context.Context, titleID string) (*domain.ChapterListResponse, Freshness, error)
 }
 
-func (m *chapterCacheManager) SetCache(titleID string) error {
-	cl, err := m.cClient.GetChapterList(titleID)
+func (m *chapterCacheManager) SetCache(ctx context.Context, titleID string) error {
+	cl, err := m.cClient.GetChapterList(ctx, titleID)
 	if err != nil {
 		return err
 	}
 
-	cs, _ := json.Marshal(cl)
+	cs, err := m.codec.Encode(cl)
+	if err != nil {
+		return err
+	}
+
+	return m.cCache.Set(ctx, titleID, cs, chapterTTL(titleID, m.getMangaStatus(ctx, titleID), cl))
+}
+
+func (m *chapterCacheManager) GetCache(ctx context.Context, titleID string) (*domain.ChapterListResponse, error) {
+	cl, _, err := m.getCache(ctx, titleID)
+	return cl, err
+}
 
-	return m.cCache.Set(titleID, string(cs))
+// GetCacheEntry also returns stale values, and takes the refresh lock of the
+// first stale read.
+func (m *chapterCacheManager) GetCacheEntry(ctx context.Context, titleID string) (*domain.ChapterListResponse, Freshness, error) {
+	cl, f, err := m.getCache(ctx, titleID)
+	if err == nil && f.Stale {
+		f.Refresh, _ = m.cCache.LockRefresh(ctx, titleID)
+	}
+	return cl, f, err
 }
 
-func (m *chapterCacheManager) GetCache(titleID string) (*domain.ChapterListResponse, error) {
-	cs, err := m.cCache.Get(titleID)
+func (m *chapterCacheManager) getCache(ctx context.Context, titleID string) (*domain.ChapterListResponse, Freshness, error) {
+	key := m.cCache.Key(titleID)
+	if v, _, ok := m.local.Get(key); ok {
+		lv := v.(localValue)
+		return lv.value.(*domain.ChapterListResponse), getFreshness(lv), nil
+	}
+
+	version := m.local.Version()
+	entry, err := m.cCache.GetEntry(ctx, titleID)
 	if err != nil {
-		return nil, err
+		return nil, Freshness{}, err
 	}
 
 	var cl *domain.ChapterListResponse
-	err = json.Unmarshal([]byte(cs), &cl)
+	err = m.codec.Decode(entry.Value, &cl)
+	if err != nil {
+		return nil, Freshness{}, errors.New("invalid chapter cache")
+	}
+
+	lv := newLocalValue(cl, entry)
+	m.local.Set(key, lv, entry.TTL, version)
+	return cl, getFreshness(lv), nil
+}
+
+// getMangaStatus looks the title up in the cached manga list. It does not
+// go to the origin, so the status is unknown while the list is not cached.
+func (m *chapterCacheManager) getMangaStatus(ctx context.Context, titleID string) domain.MangaStatus {
+	ml, err := m.mCacheManager.GetCache(ctx)
 	if err != nil {
-		return nil, errors.New("invalid chapter cache")
+		return domain.MangaStatusUnknown
 	}
 
-	return cl, nil
+	for _, dm := range ml.Mangas {
+		if dm.TitleID == titleID {
+			return domain.NewMangaStatus(dm.Status)
+		}
+	}
+	return domain.MangaStatusUnknown
 }
 
-func NewChapterCacheManager(client client.ChapterClient, cache cache.ChapterCache) *chapterCacheManager {
+func NewChapterCacheManager(client client.ChapterClient, mcm MangaCacheManager, cache cache.ChapterCache) *chapterCacheManager {
 	return &chapterCacheManager{
-		cClient: client,
-		cCache:  cache,
+		cClient:       client,
+		mCacheManager: mcm,
+		cCache:        cache,
+		local:         newLocalCache(),
+		codec:         newCodec(),
 	}
 }
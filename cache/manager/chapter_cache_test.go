@@ -1,9 +1,11 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/cache"
@@ -19,6 +21,8 @@ type ChapterCacheManagerTestSuite struct {
 	suite.Suite
 	cca cache.ChapterCache
 	ccl *mock.ChapterClientMock
+	mca cache.MangaCache
+	mcm MangaCacheManager
 }
 
 func TestChapterCacheManagerTestSuite(t *testing.T) {
@@ -34,13 +38,15 @@ func (s *ChapterCacheManagerTestSuite) SetupSuite() {
 func (s *ChapterCacheManagerTestSuite) SetupTest() {
 	s.cca = cache.NewChapterCache()
 	s.ccl = &mock.ChapterClientMock{}
+	s.mca = cache.NewMangaCache()
+	s.mcm = NewMangaCacheManager(&mock.MangaClientMock{}, s.mca)
 }
 
 func (s *ChapterCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientReturnsError() {
-	s.ccl.On("GetChapterList", "bleach").Return(nil, errors.New("some error"))
+	s.ccl.On("GetChapterList", context.Background(), "bleach").Return(nil, errors.New("some error"))
 
-	ccm := NewChapterCacheManager(s.ccl, s.cca)
-	err := ccm.SetCache("bleach")
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
+	err := ccm.SetCache(context.Background(), "bleach")
 
 	assert.Equal(s.T(), "some error", err.Error())
 	s.ccl.AssertExpectations(s.T())
@@ -48,54 +54,134 @@ func (s *ChapterCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientRetur
 
 func (s *ChapterCacheManagerTestSuite) TestSetCache_WhenSucceed() {
 	res := getFakeChapterList()
-	s.ccl.On("GetChapterList", "bleach").Return(&res, nil)
+	s.ccl.On("GetChapterList", context.Background(), "bleach").Return(&res, nil)
 
-	ccm := NewChapterCacheManager(s.ccl, s.cca)
-	err := ccm.SetCache("bleach")
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
+	err := ccm.SetCache(context.Background(), "bleach")
 
 	ec, _ := json.Marshal(res)
-	sc, _ := s.cca.Get("bleach")
+	sc, _ := s.cca.Get(context.Background(), "bleach")
 
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), string(ec), sc)
 	s.ccl.AssertExpectations(s.T())
 
-	_ = s.cca.Delete("bleach")
+	_ = s.cca.Delete(context.Background(), "bleach")
+}
+
+func (s *ChapterCacheManagerTestSuite) TestSetCache_KeepsCompletedTitleLonger() {
+	res := domain.ChapterListResponse{
+		Chapters: []domain.Chapter{{Number: "686", Title: "Bleach 686 (tamat)", TitleID: "bleach"}},
+	}
+	s.ccl.On("GetChapterList", context.Background(), "bleach").Return(&res, nil)
+	defer func() {
+		_ = s.cca.Delete(context.Background(), "bleach")
+	}()
+
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
+	err := ccm.SetCache(context.Background(), "bleach")
+
+	ttl := appcontext.GetRedisClient().PTTL(s.cca.Key("bleach")).Val()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), config.CacheTTLs().CompletedChapter.HardExpiration, ttl)
+
+	_, f, err := ccm.GetCacheEntry(context.Background(), "bleach")
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), f.Stale)
+}
+
+func (s *ChapterCacheManagerTestSuite) TestSetCache_KeepsTitleLonger_WhenMangaStatusIsCompleted() {
+	manga := getFakeLatestManga()
+	manga.Status = "Completed"
+	ms, _ := json.Marshal(domain.MangaListResponse{Mangas: []domain.Manga{manga}})
+	_ = s.mca.Set(context.Background(), string(ms))
+
+	res := getFakeChapterList()
+	s.ccl.On("GetChapterList", context.Background(), "kagamigami").Return(&res, nil)
+	defer func() {
+		_ = s.cca.Delete(context.Background(), "kagamigami")
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
+	err := ccm.SetCache(context.Background(), "kagamigami")
+
+	ttl := appcontext.GetRedisClient().PTTL(s.cca.Key("kagamigami")).Val()
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), config.CacheTTLs().CompletedChapter.HardExpiration, ttl)
+}
+
+func (s *ChapterCacheManagerTestSuite) TestGetCacheEntry_KeepsWritePolicy_WhenMangaStatusChangesAfterWrite() {
+	res := getFakeChapterList()
+	s.ccl.On("GetChapterList", context.Background(), "kagamigami").Return(&res, nil)
+	defer func() {
+		_ = s.cca.Delete(context.Background(), "kagamigami")
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	err := NewChapterCacheManager(s.ccl, s.mcm, s.cca).SetCache(context.Background(), "kagamigami")
+	assert.Nil(s.T(), err)
+
+	manga := getFakeLatestManga()
+	manga.Status = "Completed"
+	ms, _ := json.Marshal(domain.MangaListResponse{Mangas: []domain.Manga{manga}})
+	_ = s.mca.Set(context.Background(), string(ms))
+
+	_, f, err := NewChapterCacheManager(s.ccl, s.mcm, s.cca).GetCacheEntry(context.Background(), "kagamigami")
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), f.Stale)
+	assert.True(s.T(), f.Age < time.Minute)
+}
+
+func (s *ChapterCacheManagerTestSuite) TestGetCacheEntry_ReturnsStale_WhenValueHasNoWriteTimes() {
+	cb, _ := json.Marshal(getFakeChapterList())
+	appcontext.GetRedisClient().Set(s.cca.Key("bleach"), string(cb), time.Hour)
+	defer func() {
+		_ = s.cca.Delete(context.Background(), "bleach")
+		appcontext.GetRedisClient().Del("RefreshLock|" + s.cca.Key("bleach"))
+	}()
+
+	_, f, err := NewChapterCacheManager(s.ccl, s.mcm, s.cca).GetCacheEntry(context.Background(), "bleach")
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), f.Stale)
+	assert.True(s.T(), f.Refresh)
 }
 
 func (s *ChapterCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMissing() {
-	ccm := NewChapterCacheManager(s.ccl, s.cca)
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
 
-	cl, err := ccm.GetCache("bleach")
+	cl, err := ccm.GetCache(context.Background(), "bleach")
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), "redis: nil", err.Error())
 }
 
 func (s *ChapterCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsInvalid() {
-	ccm := NewChapterCacheManager(s.ccl, s.cca)
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
 
-	_ = s.cca.Set("bleach", "foo")
+	_ = s.cca.Set(context.Background(), "bleach", "foo", config.CacheTTLs().Chapter)
 	defer func() {
-		_ = s.cca.Delete("bleach")
+		_ = s.cca.Delete(context.Background(), "bleach")
 	}()
 
-	cl, err := ccm.GetCache("bleach")
+	cl, err := ccm.GetCache(context.Background(), "bleach")
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), "invalid chapter cache", err.Error())
 }
 
 func (s *ChapterCacheManagerTestSuite) TestGetCache_ReturnsChapterList_WhenCacheIsStored() {
-	ccm := NewChapterCacheManager(s.ccl, s.cca)
+	ccm := NewChapterCacheManager(s.ccl, s.mcm, s.cca)
 
 	cb, _ := json.Marshal(getFakeChapterList())
-	_ = s.cca.Set("bleach", string(cb))
+	_ = s.cca.Set(context.Background(), "bleach", string(cb), config.CacheTTLs().Chapter)
 	defer func() {
-		_ = s.cca.Delete("bleach")
+		_ = s.cca.Delete(context.Background(), "bleach")
 	}()
 
-	cl, err := ccm.GetCache("bleach")
+	cl, err := ccm.GetCache(context.Background(), "bleach")
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(cl.Chapters) > 0)
@@ -105,7 +191,7 @@ func getFakeChapterList() domain.ChapterListResponse {
 	return domain.ChapterListResponse{
 		Chapters: []domain.Chapter{
 			{
-				Number:       650.0,
+				Number:       "650",
 				Title:        "Bleach",
 				TitleID:      "bleach",
 				ModifiedDate: "2016-08-18 18:59:58",
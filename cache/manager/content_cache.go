@@ -1,54 +1,85 @@
 package manager
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 
 	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/client"
-	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/domain"
 )
 
 type contentCacheManager struct {
 	cClient client.ContentClient
 	cCache  cache.ContentCache
+	local   cache.LocalCache
+	codec   cache.Codec
 }
 
 type ContentCacheManager interface {
-	SetCache(titleID string, chapter float32) error
-	GetCache(titleID string, chapter float32) (*domain.ContentListResponse, error)
+	SetCache(ctx context.Context, titleID string, chapter domain.ChapterID) error
+	GetCache(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error)
+	GetCacheEntry(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, Freshness, error)
 }
 
-func (m *contentCacheManager) SetCache(titleID string, chapter float32) error {
-	cl, err := m.cClient.GetContentList(titleID, chapter)
+func (m *contentCacheManager) SetCache(ctx context.Context, titleID string, chapter domain.ChapterID) error {
+	cl, err := m.cClient.GetContentList(ctx, titleID, chapter)
 	if err != nil {
 		return err
 	}
 
-	cs, _ := json.Marshal(cl)
+	cs, err := m.codec.Encode(cl)
+	if err != nil {
+		return err
+	}
+
+	return m.cCache.Set(ctx, titleID, chapter.String(), cs)
+}
 
-	return m.cCache.Set(titleID, common.GetFormattedChapterNumber(chapter), string(cs))
+func (m *contentCacheManager) GetCache(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error) {
+	cl, _, err := m.getCache(ctx, titleID, chapter)
+	return cl, err
 }
 
-func (m *contentCacheManager) GetCache(titleID string, chapter float32) (*domain.ContentListResponse, error) {
-	cs, err := m.cCache.Get(titleID, common.GetFormattedChapterNumber(chapter))
+// GetCacheEntry also returns stale values, and takes the refresh lock of the
+// first stale read.
+func (m *contentCacheManager) GetCacheEntry(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, Freshness, error) {
+	cl, f, err := m.getCache(ctx, titleID, chapter)
+	if err == nil && f.Stale {
+		f.Refresh, _ = m.cCache.LockRefresh(ctx, titleID, chapter.String())
+	}
+	return cl, f, err
+}
+
+func (m *contentCacheManager) getCache(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, Freshness, error) {
+	key := m.cCache.Key(titleID, chapter.String())
+	if v, _, ok := m.local.Get(key); ok {
+		lv := v.(localValue)
+		return lv.value.(*domain.ContentListResponse), getFreshness(lv), nil
+	}
+
+	version := m.local.Version()
+	entry, err := m.cCache.GetEntry(ctx, titleID, chapter.String())
 	if err != nil {
-		return nil, err
+		return nil, Freshness{}, err
 	}
 
 	var cl *domain.ContentListResponse
-	err = json.Unmarshal([]byte(cs), &cl)
+	err = m.codec.Decode(entry.Value, &cl)
 	if err != nil {
-		return nil, errors.New("invalid content cache")
+		return nil, Freshness{}, errors.New("invalid content cache")
 	}
 
-	return cl, nil
+	lv := newLocalValue(cl, entry)
+	m.local.Set(key, lv, entry.TTL, version)
+	return cl, getFreshness(lv), nil
 }
 
 func NewContentCacheManager(client client.ContentClient, cache cache.ContentCache) *contentCacheManager {
 	return &contentCacheManager{
 		cClient: client,
 		cCache:  cache,
+		local:   newLocalCache(),
+		codec:   newCodec(),
 	}
 }
@@ -1,13 +1,16 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"os"
 	"testing"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/bigscreen/mangindo-feeder/mock"
@@ -37,11 +40,11 @@ func (s *ContentCacheManagerTestSuite) SetupTest() {
 }
 
 func (s *ContentCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientReturnsError() {
-	s.ccl.On("GetContentList", "bleach", float32(650.0)).
+	s.ccl.On("GetContentList", context.Background(), "bleach", domain.ChapterID("650")).
 		Return(nil, errors.New("some error"))
 
 	ccm := NewContentCacheManager(s.ccl, s.cca)
-	err := ccm.SetCache("bleach", float32(650.0))
+	err := ccm.SetCache(context.Background(), "bleach", domain.ChapterID("650"))
 
 	assert.Equal(s.T(), "some error", err.Error())
 	s.ccl.AssertExpectations(s.T())
@@ -49,25 +52,56 @@ func (s *ContentCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientRetur
 
 func (s *ContentCacheManagerTestSuite) TestSetCache_WhenSucceed() {
 	res := getFakeContentList()
-	s.ccl.On("GetContentList", "bleach", float32(650.0)).Return(&res, nil)
+	s.ccl.On("GetContentList", context.Background(), "bleach", domain.ChapterID("650")).Return(&res, nil)
 
 	ccm := NewContentCacheManager(s.ccl, s.cca)
-	err := ccm.SetCache("bleach", float32(650.0))
+	err := ccm.SetCache(context.Background(), "bleach", domain.ChapterID("650"))
 
 	ec, _ := json.Marshal(res)
-	sc, _ := s.cca.Get("bleach", "650")
+	sc, _ := s.cca.Get(context.Background(), "bleach", "650")
 
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), string(ec), sc)
 	s.ccl.AssertExpectations(s.T())
 
-	_ = s.cca.Delete("bleach", "650")
+	_ = s.cca.Delete(context.Background(), "bleach", "650")
+}
+
+func (s *ContentCacheManagerTestSuite) TestSetCache_StoresEncodedValue_WhenCodecIsConfigured() {
+	format := os.Getenv("CACHE_CODEC_FORMAT")
+	compression := os.Getenv("CACHE_CODEC_COMPRESSION")
+	_ = os.Setenv("CACHE_CODEC_FORMAT", constants.CacheFormatMsgpack)
+	_ = os.Setenv("CACHE_CODEC_COMPRESSION", constants.CacheCompressionZstd)
+	config.Load()
+	defer func() {
+		_ = os.Setenv("CACHE_CODEC_FORMAT", format)
+		_ = os.Setenv("CACHE_CODEC_COMPRESSION", compression)
+		config.Load()
+	}()
+
+	res := getFakeContentList()
+	s.ccl.On("GetContentList", context.Background(), "bleach", domain.ChapterID("650")).Return(&res, nil)
+	defer func() {
+		_ = s.cca.Delete(context.Background(), "bleach", "650")
+	}()
+
+	ccm := NewContentCacheManager(s.ccl, s.cca)
+	err := ccm.SetCache(context.Background(), "bleach", "650")
+	assert.Nil(s.T(), err)
+
+	sc, _ := s.cca.Get(context.Background(), "bleach", "650")
+	ec, _ := json.Marshal(res)
+	assert.NotEqual(s.T(), string(ec), sc)
+
+	cl, err := NewContentCacheManager(s.ccl, s.cca).GetCache(context.Background(), "bleach", "650")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), res, *cl)
 }
 
 func (s *ContentCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMissing() {
 	ccm := NewContentCacheManager(s.ccl, s.cca)
 
-	cl, err := ccm.GetCache("bleach", float32(650.0))
+	cl, err := ccm.GetCache(context.Background(), "bleach", domain.ChapterID("650"))
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), "redis: nil", err.Error())
@@ -76,12 +110,12 @@ func (s *ContentCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMiss
 func (s *ContentCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsInvalid() {
 	ccm := NewContentCacheManager(s.ccl, s.cca)
 
-	_ = s.cca.Set("bleach", "650", "foo")
+	_ = s.cca.Set(context.Background(), "bleach", "650", "foo")
 	defer func() {
-		_ = s.cca.Delete("bleach", "650")
+		_ = s.cca.Delete(context.Background(), "bleach", "650")
 	}()
 
-	cl, err := ccm.GetCache("bleach", float32(650.0))
+	cl, err := ccm.GetCache(context.Background(), "bleach", domain.ChapterID("650"))
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), "invalid content cache", err.Error())
@@ -91,12 +125,12 @@ func (s *ContentCacheManagerTestSuite) TestGetCache_ReturnsContentList_WhenCache
 	ccm := NewContentCacheManager(s.ccl, s.cca)
 
 	cb, _ := json.Marshal(getFakeContentList())
-	_ = s.cca.Set("bleach", "650", string(cb))
+	_ = s.cca.Set(context.Background(), "bleach", "650", string(cb))
 	defer func() {
-		_ = s.cca.Delete("bleach", "650")
+		_ = s.cca.Delete(context.Background(), "bleach", "650")
 	}()
 
-	cl, err := ccm.GetCache("bleach", float32(650.0))
+	cl, err := ccm.GetCache(context.Background(), "bleach", domain.ChapterID("650"))
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(cl.Contents) > 0)
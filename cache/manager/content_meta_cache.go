@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+type contentMetaCacheManager struct {
+	iClient   client.ImageClient
+	ccManager ContentCacheManager
+	cmCache   cache.ContentMetaCache
+	codec     cache.Codec
+}
+
+type ContentMetaCacheManager interface {
+	SetCache(ctx context.Context, titleID string, chapter domain.ChapterID) error
+	GetCache(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentMetaResponse, error)
+}
+
+// SetCache probes every page image of a cached chapter. Pages that fail are
+// left out so the rest of the chapter still gets its metadata.
+func (m *contentMetaCacheManager) SetCache(ctx context.Context, titleID string, chapter domain.ChapterID) error {
+	cl, err := m.ccManager.GetCache(ctx, titleID, chapter)
+	if err != nil {
+		return err
+	}
+
+	cm := &domain.ContentMetaResponse{Images: map[string]domain.ImageMeta{}}
+	for _, dc := range cl.Contents {
+		if _, ok := cm.Images[dc.ImageURL]; ok {
+			continue
+		}
+
+		meta, err := m.iClient.GetImageMeta(ctx, dc.ImageURL)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Warnf("Failed to probe page %d of %s %s: %s", dc.Page, titleID, chapter, err.Error())
+			continue
+		}
+		cm.Images[dc.ImageURL] = *meta
+	}
+
+	if len(cm.Images) == 0 {
+		return errors.New("no page image could be probed")
+	}
+
+	cs, err := m.codec.Encode(cm)
+	if err != nil {
+		return err
+	}
+
+	return m.cmCache.Set(ctx, titleID, chapter.String(), cs)
+}
+
+func (m *contentMetaCacheManager) GetCache(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentMetaResponse, error) {
+	cs, err := m.cmCache.Get(ctx, titleID, chapter.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var cm *domain.ContentMetaResponse
+	err = m.codec.Decode(cs, &cm)
+	if err != nil || cm == nil {
+		return nil, errors.New("invalid content meta cache")
+	}
+
+	return cm, nil
+}
+
+func NewContentMetaCacheManager(client client.ImageClient, ccm ContentCacheManager, cache cache.ContentMetaCache) *contentMetaCacheManager {
+	return &contentMetaCacheManager{
+		iClient:   client,
+		ccManager: ccm,
+		cmCache:   cache,
+		codec:     newCodec(),
+	}
+}
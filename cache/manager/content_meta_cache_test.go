@@ -0,0 +1,104 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContentMetaCacheManagerTestSuite struct {
+	suite.Suite
+	cca  cache.ContentCache
+	cmca cache.ContentMetaCache
+	ic   *mock.ImageClientMock
+	ccm  ContentCacheManager
+}
+
+func TestContentMetaCacheManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentMetaCacheManagerTestSuite))
+}
+
+func (s *ContentMetaCacheManagerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *ContentMetaCacheManagerTestSuite) SetupTest() {
+	s.cca = cache.NewContentCache()
+	s.cmca = cache.NewContentMetaCache()
+	s.ic = &mock.ImageClientMock{}
+	s.ccm = NewContentCacheManager(&mock.ContentClientMock{}, s.cca)
+}
+
+func (s *ContentMetaCacheManagerTestSuite) TearDownTest() {
+	_ = s.cca.Delete(context.Background(), "bleach", "650")
+	_ = s.cmca.Delete(context.Background(), "bleach", "650")
+}
+
+func (s *ContentMetaCacheManagerTestSuite) setContentCache(cl domain.ContentListResponse) {
+	cb, _ := json.Marshal(cl)
+	_ = s.cca.Set(context.Background(), "bleach", "650", string(cb))
+}
+
+func (s *ContentMetaCacheManagerTestSuite) TestSetCache_ReturnsError_WhenContentCacheIsMissing() {
+	cmm := NewContentMetaCacheManager(s.ic, s.ccm, s.cmca)
+	err := cmm.SetCache(context.Background(), "bleach", domain.ChapterID("650"))
+
+	assert.Equal(s.T(), "redis: nil", err.Error())
+	s.ic.AssertNotCalled(s.T(), "GetImageMeta")
+}
+
+func (s *ContentMetaCacheManagerTestSuite) TestSetCache_StoresProbedPages_AndSkipsFailedOnes() {
+	s.setContentCache(domain.ContentListResponse{
+		Contents: []domain.Content{
+			{ImageURL: "http://foo.com/1.jpg", Page: 1},
+			{ImageURL: "http://foo.com/2.jpg", Page: 2},
+			{ImageURL: "http://foo.com/1.jpg", Page: 3},
+		},
+	})
+	meta := &domain.ImageMeta{Width: 800, Height: 1200, Size: 123456}
+	s.ic.On("GetImageMeta", context.Background(), "http://foo.com/1.jpg").Return(meta, nil).Once()
+	s.ic.On("GetImageMeta", context.Background(), "http://foo.com/2.jpg").Return(nil, errors.New("some error")).Once()
+
+	cmm := NewContentMetaCacheManager(s.ic, s.ccm, s.cmca)
+	err := cmm.SetCache(context.Background(), "bleach", domain.ChapterID("650"))
+	assert.Nil(s.T(), err)
+
+	cm, err := cmm.GetCache(context.Background(), "bleach", domain.ChapterID("650"))
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), map[string]domain.ImageMeta{"http://foo.com/1.jpg": *meta}, cm.Images)
+	s.ic.AssertExpectations(s.T())
+}
+
+func (s *ContentMetaCacheManagerTestSuite) TestSetCache_ReturnsError_WhenNoPageCanBeProbed() {
+	s.setContentCache(getFakeContentList())
+	s.ic.On("GetImageMeta", context.Background(), "http://foo.com/foo.jpg").Return(nil, errors.New("some error"))
+
+	cmm := NewContentMetaCacheManager(s.ic, s.ccm, s.cmca)
+	err := cmm.SetCache(context.Background(), "bleach", domain.ChapterID("650"))
+
+	assert.Equal(s.T(), "no page image could be probed", err.Error())
+	_, err = s.cmca.Get(context.Background(), "bleach", "650")
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ContentMetaCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsInvalid() {
+	_ = s.cmca.Set(context.Background(), "bleach", "650", "foo")
+
+	cmm := NewContentMetaCacheManager(s.ic, s.ccm, s.cmca)
+	cm, err := cmm.GetCache(context.Background(), "bleach", domain.ChapterID("650"))
+
+	assert.Nil(s.T(), cm)
+	assert.Equal(s.T(), "invalid content meta cache", err.Error())
+}
@@ -0,0 +1,48 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/cache"
+)
+
+// Freshness tells how old a cached value is. Stale values are past their
+// soft expiration, and Refresh is set for the one caller that should enqueue
+// their refresh job.
+type Freshness struct {
+	Age     time.Duration
+	Stale   bool
+	Refresh bool
+}
+
+// localValue is a decoded value kept in the local cache, along with the
+// times of the Redis entry it was read from.
+type localValue struct {
+	value    interface{}
+	storedAt time.Time
+	staleAt  time.Time
+}
+
+func newLocalValue(value interface{}, entry cache.Entry) localValue {
+	return localValue{value: value, storedAt: entry.StoredAt, staleAt: entry.StaleAt}
+}
+
+// getFreshness tells the age of a value from the times it was written with,
+// so it follows the policy of the write whatever the policy is now. Values
+// without those times have an unknown age, and are taken as stale so they
+// get rewritten.
+func getFreshness(v localValue) Freshness {
+	if v.staleAt.IsZero() {
+		return Freshness{Stale: true}
+	}
+
+	now := time.Now()
+	age := now.Sub(v.storedAt)
+	if age < 0 {
+		age = 0
+	}
+	return Freshness{
+		Age:   age,
+		Stale: now.After(v.staleAt),
+	}
+}
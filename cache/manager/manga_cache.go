@@ -1,7 +1,7 @@
 package manager
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 
 	"github.com/bigscreen/mangindo-feeder/cache"
@@ -12,42 +12,74 @@ import (
 type mangaCacheManager struct {
 	mClient client.MangaClient
 	mCache  cache.MangaCache
+	local   cache.LocalCache
+	codec   cache.Codec
 }
 
 type MangaCacheManager interface {
-	SetCache() error
-	GetCache() (*domain.MangaListResponse, error)
+	SetCache(ctx context.Context) error
+	GetCache(ctx context.Context) (*domain.MangaListResponse, error)
+	GetCacheEntry(ctx context.Context) (*domain.MangaListResponse, Freshness, error)
 }
 
-func (m *mangaCacheManager) SetCache() error {
-	ml, err := m.mClient.GetMangaList()
+func (m *mangaCacheManager) SetCache(ctx context.Context) error {
+	ml, err := m.mClient.GetMangaList(ctx)
 	if err != nil {
 		return err
 	}
 
-	ms, _ := json.Marshal(ml)
+	ms, err := m.codec.Encode(ml)
+	if err != nil {
+		return err
+	}
+
+	return m.mCache.Set(ctx, ms)
+}
 
-	return m.mCache.Set(string(ms))
+func (m *mangaCacheManager) GetCache(ctx context.Context) (*domain.MangaListResponse, error) {
+	ml, _, err := m.getCache(ctx)
+	return ml, err
 }
 
-func (m *mangaCacheManager) GetCache() (*domain.MangaListResponse, error) {
-	ms, err := m.mCache.Get()
+// GetCacheEntry also returns stale values, and takes the refresh lock of the
+// first stale read.
+func (m *mangaCacheManager) GetCacheEntry(ctx context.Context) (*domain.MangaListResponse, Freshness, error) {
+	ml, f, err := m.getCache(ctx)
+	if err == nil && f.Stale {
+		f.Refresh, _ = m.mCache.LockRefresh(ctx)
+	}
+	return ml, f, err
+}
+
+func (m *mangaCacheManager) getCache(ctx context.Context) (*domain.MangaListResponse, Freshness, error) {
+	key := m.mCache.Key()
+	if v, _, ok := m.local.Get(key); ok {
+		lv := v.(localValue)
+		return lv.value.(*domain.MangaListResponse), getFreshness(lv), nil
+	}
+
+	version := m.local.Version()
+	entry, err := m.mCache.GetEntry(ctx)
 	if err != nil {
-		return nil, err
+		return nil, Freshness{}, err
 	}
 
 	var ml *domain.MangaListResponse
-	err = json.Unmarshal([]byte(ms), &ml)
+	err = m.codec.Decode(entry.Value, &ml)
 	if err != nil {
-		return nil, errors.New("invalid manga cache")
+		return nil, Freshness{}, errors.New("invalid manga cache")
 	}
 
-	return ml, nil
+	lv := newLocalValue(ml, entry)
+	m.local.Set(key, lv, entry.TTL, version)
+	return ml, getFreshness(lv), nil
 }
 
 func NewMangaCacheManager(client client.MangaClient, cache cache.MangaCache) *mangaCacheManager {
 	return &mangaCacheManager{
 		mClient: client,
 		mCache:  cache,
+		local:   newLocalCache(),
+		codec:   newCodec(),
 	}
 }
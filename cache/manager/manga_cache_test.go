@@ -1,9 +1,11 @@
 package manager
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/cache"
@@ -37,10 +39,10 @@ func (s *MangaCacheManagerTestSuite) SetupTest() {
 }
 
 func (s *MangaCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientReturnsError() {
-	s.mcl.On("GetMangaList").Return(nil, errors.New("some error"))
+	s.mcl.On("GetMangaList", context.Background()).Return(nil, errors.New("some error"))
 
 	mcm := NewMangaCacheManager(s.mcl, s.mca)
-	err := mcm.SetCache()
+	err := mcm.SetCache(context.Background())
 
 	assert.Equal(s.T(), "some error", err.Error())
 	s.mcl.AssertExpectations(s.T())
@@ -48,24 +50,24 @@ func (s *MangaCacheManagerTestSuite) TestSetCache_ReturnsError_WhenClientReturns
 
 func (s *MangaCacheManagerTestSuite) TestSetCache_Succeed() {
 	res := getFakeMangaList()
-	s.mcl.On("GetMangaList").Return(&res, nil)
+	s.mcl.On("GetMangaList", context.Background()).Return(&res, nil)
 
 	mcm := NewMangaCacheManager(s.mcl, s.mca)
-	err := mcm.SetCache()
+	err := mcm.SetCache(context.Background())
 
 	expCache, _ := json.Marshal(res)
-	storedCache, _ := s.mca.Get()
+	storedCache, _ := s.mca.Get(context.Background())
 
 	assert.Nil(s.T(), err)
 	assert.Equal(s.T(), string(expCache), storedCache)
 	s.mcl.AssertExpectations(s.T())
 
-	_ = s.mca.Delete()
+	_ = s.mca.Delete(context.Background())
 }
 
 func (s *MangaCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMissing() {
 	mcm := NewMangaCacheManager(s.mcl, s.mca)
-	ml, err := mcm.GetCache()
+	ml, err := mcm.GetCache(context.Background())
 
 	assert.Nil(s.T(), ml)
 	assert.Equal(s.T(), "redis: nil", err.Error())
@@ -74,12 +76,12 @@ func (s *MangaCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMissin
 func (s *MangaCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsInvalid() {
 	mcm := NewMangaCacheManager(s.mcl, s.mca)
 
-	_ = s.mca.Set("foo")
+	_ = s.mca.Set(context.Background(), "foo")
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
-	ml, err := mcm.GetCache()
+	ml, err := mcm.GetCache(context.Background())
 
 	assert.Nil(s.T(), ml)
 	assert.Equal(s.T(), "invalid manga cache", err.Error())
@@ -89,17 +91,95 @@ func (s *MangaCacheManagerTestSuite) TestGetCache_ReturnsMangaList_WhenCacheIsSt
 	mcm := NewMangaCacheManager(s.mcl, s.mca)
 
 	cb, _ := json.Marshal(getFakeMangaList())
-	_ = s.mca.Set(string(cb))
+	_ = s.mca.Set(context.Background(), string(cb))
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
-	ml, err := mcm.GetCache()
+	ml, err := mcm.GetCache(context.Background())
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(ml.Mangas) > 0)
 }
 
+func (s *MangaCacheManagerTestSuite) TestGetCacheEntry_ReturnsFreshEntry_WhenCacheIsNew() {
+	mcm := NewMangaCacheManager(s.mcl, s.mca)
+
+	cb, _ := json.Marshal(getFakeMangaList())
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	ml, f, err := mcm.GetCacheEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), len(ml.Mangas) > 0)
+	assert.False(s.T(), f.Stale)
+	assert.False(s.T(), f.Refresh)
+}
+
+func (s *MangaCacheManagerTestSuite) TestGetCacheEntry_RefreshesOnce_WhenCacheIsStale() {
+	mcm := NewMangaCacheManager(s.mcl, s.mca)
+
+	cb, _ := json.Marshal(getFakeMangaList())
+	_ = s.mca.Set(context.Background(), string(cb))
+	ageCacheEntry("MangasCache", 23*time.Hour, config.CacheTTLs().Manga)
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+		appcontext.GetRedisClient().Del("RefreshLock|MangasCache")
+	}()
+
+	ml, f, err := mcm.GetCacheEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), len(ml.Mangas) > 0)
+	assert.True(s.T(), f.Stale)
+	assert.True(s.T(), f.Refresh)
+	assert.True(s.T(), f.Age >= 23*time.Hour)
+
+	_, f, err = mcm.GetCacheEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), f.Stale)
+	assert.False(s.T(), f.Refresh)
+}
+
+func (s *MangaCacheManagerTestSuite) TestGetCache_ReturnsLocalValue_WhenRedisWasNotWrittenSince() {
+	mcm := NewMangaCacheManager(s.mcl, s.mca)
+
+	cb, _ := json.Marshal(getFakeMangaList())
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	first, _ := mcm.GetCache(context.Background())
+	appcontext.GetRedisClient().Set("MangasCache", "foo", time.Hour)
+	second, err := mcm.GetCache(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), first == second)
+}
+
+func (s *MangaCacheManagerTestSuite) TestGetCache_ReadsRedisAgain_AfterCacheIsWritten() {
+	mcm := NewMangaCacheManager(s.mcl, s.mca)
+
+	cb, _ := json.Marshal(getFakeMangaList())
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	_, _ = mcm.GetCache(context.Background())
+	cb, _ = json.Marshal(domain.MangaListResponse{Mangas: []domain.Manga{getFakeLatestManga()}})
+	_ = s.mca.Set(context.Background(), string(cb))
+	ml, err := mcm.GetCache(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(ml.Mangas))
+}
+
 func getFakeMangaList() domain.MangaListResponse {
 	return domain.MangaListResponse{
 		Mangas: []domain.Manga{
@@ -142,3 +222,11 @@ func getFakeLatestManga() domain.Manga {
 		Summary:      "Lorem ipsum...",
 	}
 }
+
+// ageCacheEntry rewrites the write times of a cached value as if it had been
+// written age ago.
+func ageCacheEntry(key string, age time.Duration, ttl config.CacheTTL) {
+	storedAt := time.Now().Add(-age)
+	times, _ := json.Marshal(map[string]time.Time{"stored_at": storedAt, "stale_at": storedAt.Add(ttl.Expiration)})
+	appcontext.GetRedisClient().Set("CacheTimes|"+key, times, ttl.HardExpiration)
+}
@@ -0,0 +1,64 @@
+package manager
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+var completedChapterMarkers = []string{"tamat", "completed", "finished"}
+
+// chapterTTL picks the lifetime of the chapter list of a title. A title set
+// in the config comes first, then completed titles, which rarely change, then
+// popular ones, which get new chapters first.
+func chapterTTL(titleID string, status domain.MangaStatus, cl *domain.ChapterListResponse) config.CacheTTL {
+	ttls := config.CacheTTLs()
+	if ttl, ok := ttls.TitleChapters[titleID]; ok {
+		return ttl
+	}
+	if isCompletedTitle(status, cl) {
+		return ttls.CompletedChapter
+	}
+	if config.IsPopularManga(titleID) {
+		return ttls.PopularChapter
+	}
+	return ttls.Chapter
+}
+
+// isCompletedTitle goes by the status of the title in the manga list, and
+// only looks for a completed marker in the chapters when it is unknown.
+func isCompletedTitle(status domain.MangaStatus, cl *domain.ChapterListResponse) bool {
+	if status != domain.MangaStatusUnknown {
+		return status == domain.MangaStatusCompleted
+	}
+	return isCompletedChapterList(cl)
+}
+
+// isCompletedChapterList tells whether the newest chapter is marked as the
+// last one, like "Bleach 686 - Death & Strawberry (tamat)".
+func isCompletedChapterList(cl *domain.ChapterListResponse) bool {
+	if cl == nil || len(cl.Chapters) == 0 {
+		return false
+	}
+
+	newest := cl.Chapters[0]
+	for _, c := range cl.Chapters[1:] {
+		if c.Number.Compare(newest.Number) > 0 {
+			newest = c
+		}
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(newest.Title), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, w := range words {
+		for _, m := range completedChapterMarkers {
+			if w == m {
+				return true
+			}
+		}
+	}
+	return false
+}
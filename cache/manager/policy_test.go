@@ -0,0 +1,99 @@
+package manager
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type PolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyTestSuite))
+}
+
+func (s *PolicyTestSuite) SetupSuite() {
+	config.Load()
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsDefault_WhenNoRuleMatches() {
+	cl := getFakeChapterList()
+
+	assert.Equal(s.T(), config.CacheTTLs().Chapter, chapterTTL("bleach", domain.MangaStatusUnknown, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsCompletedTTL_WhenNewestChapterIsMarkedTamat() {
+	cl := domain.ChapterListResponse{
+		Chapters: []domain.Chapter{
+			{Number: "685", Title: "Bleach 685 - A Perfect End"},
+			{Number: "686", Title: "Bleach 686 - Death & Strawberry (tamat)"},
+		},
+	}
+
+	assert.Equal(s.T(), config.CacheTTLs().CompletedChapter, chapterTTL("bleach", domain.MangaStatusUnknown, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsCompletedTTL_WhenMangaStatusIsCompleted() {
+	cl := getFakeChapterList()
+
+	assert.Equal(s.T(), config.CacheTTLs().CompletedChapter, chapterTTL("bleach", domain.MangaStatusCompleted, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsDefault_WhenMangaStatusIsOngoing() {
+	cl := domain.ChapterListResponse{
+		Chapters: []domain.Chapter{{Number: "686", Title: "Bleach 686 (tamat)"}},
+	}
+
+	assert.Equal(s.T(), config.CacheTTLs().Chapter, chapterTTL("bleach", domain.MangaStatusOngoing, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsDefault_WhenOnlyOlderChapterIsMarkedTamat() {
+	cl := domain.ChapterListResponse{
+		Chapters: []domain.Chapter{
+			{Number: "10", Title: "Arc 1 Tamat"},
+			{Number: "11", Title: "Arc 2"},
+		},
+	}
+
+	assert.Equal(s.T(), config.CacheTTLs().Chapter, chapterTTL("bleach", domain.MangaStatusUnknown, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsPopularTTL_WhenTitleIsPopular() {
+	tags := os.Getenv("POPULAR_MANGA_TAGS")
+	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
+	config.Load()
+	defer func() {
+		_ = os.Setenv("POPULAR_MANGA_TAGS", tags)
+		config.Load()
+	}()
+
+	cl := getFakeChapterList()
+
+	assert.Equal(s.T(), config.CacheTTLs().PopularChapter, chapterTTL("one_piece", domain.MangaStatusUnknown, &cl))
+}
+
+func (s *PolicyTestSuite) TestChapterTTL_ReturnsTitleTTL_WhenTitleIsOverridden() {
+	overrides := os.Getenv("CHAPTER_CACHE_TITLE_EXPIRATIONS_MN")
+	_ = os.Setenv("CHAPTER_CACHE_TITLE_EXPIRATIONS_MN", "bleach: 5")
+	config.Load()
+	defer func() {
+		_ = os.Setenv("CHAPTER_CACHE_TITLE_EXPIRATIONS_MN", overrides)
+		config.Load()
+	}()
+
+	cl := domain.ChapterListResponse{
+		Chapters: []domain.Chapter{{Number: "686", Title: "Bleach 686 (tamat)"}},
+	}
+
+	assert.Equal(s.T(), config.CacheTTL{
+		Expiration:     5 * time.Minute,
+		HardExpiration: config.CacheTTLs().Chapter.HardExpiration,
+	}, chapterTTL("bleach", domain.MangaStatusUnknown, &cl))
+}
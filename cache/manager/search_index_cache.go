@@ -0,0 +1,71 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/search"
+)
+
+type searchIndexCacheManager struct {
+	mCacheManager MangaCacheManager
+	sCache        cache.SearchIndexCache
+}
+
+type SearchIndexCacheManager interface {
+	SetCache(ctx context.Context) error
+	GetCache(ctx context.Context) (*search.Index, error)
+	GetVersion(ctx context.Context) (string, error)
+	LockRefresh(ctx context.Context) (bool, error)
+}
+
+func (m *searchIndexCacheManager) SetCache(ctx context.Context) error {
+	ml, err := m.mCacheManager.GetCache(ctx)
+	if err != nil {
+		return err
+	}
+
+	ms, _ := json.Marshal(ml.Mangas)
+	sum := sha256.Sum256(ms)
+	version := hex.EncodeToString(sum[:])
+
+	is, _ := json.Marshal(search.NewIndex(version, ml.Mangas))
+
+	return m.sCache.Set(ctx, version, string(is))
+}
+
+func (m *searchIndexCacheManager) GetCache(ctx context.Context) (*search.Index, error) {
+	is, err := m.sCache.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var idx *search.Index
+	err = json.Unmarshal([]byte(is), &idx)
+	if err != nil {
+		return nil, errors.New("invalid search index cache")
+	}
+
+	return idx, nil
+}
+
+func (m *searchIndexCacheManager) GetVersion(ctx context.Context) (string, error) {
+	return m.sCache.GetVersion(ctx)
+}
+
+// LockRefresh lets a single caller enqueue an index rebuild while the index
+// is missing, instead of every search that misses it.
+func (m *searchIndexCacheManager) LockRefresh(ctx context.Context) (bool, error) {
+	return m.sCache.LockRefresh(ctx)
+}
+
+func NewSearchIndexCacheManager(mcm MangaCacheManager, cache cache.SearchIndexCache) *searchIndexCacheManager {
+	return &searchIndexCacheManager{
+		mCacheManager: mcm,
+		sCache:        cache,
+	}
+}
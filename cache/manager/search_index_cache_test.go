@@ -0,0 +1,102 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/bigscreen/mangindo-feeder/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchIndexCacheManagerTestSuite struct {
+	suite.Suite
+	mca cache.MangaCache
+	sca cache.SearchIndexCache
+	mcm MangaCacheManager
+}
+
+func TestSearchIndexCacheManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchIndexCacheManagerTestSuite))
+}
+
+func (s *SearchIndexCacheManagerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *SearchIndexCacheManagerTestSuite) SetupTest() {
+	s.mca = cache.NewMangaCache()
+	s.sca = cache.NewSearchIndexCache()
+	s.mcm = NewMangaCacheManager(&mock.MangaClientMock{}, s.mca)
+}
+
+func (s *SearchIndexCacheManagerTestSuite) TestSetCache_ReturnsError_WhenMangaCacheIsMissing() {
+	sicm := NewSearchIndexCacheManager(s.mcm, s.sca)
+	err := sicm.SetCache(context.Background())
+
+	assert.Equal(s.T(), "redis: nil", err.Error())
+}
+
+func (s *SearchIndexCacheManagerTestSuite) TestSetCache_StoresIndexAndVersion_WhenMangaCacheIsStored() {
+	mb, _ := json.Marshal(getFakeMangaList())
+	_ = s.mca.Set(context.Background(), string(mb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+		_ = s.sca.Delete(context.Background())
+	}()
+
+	sicm := NewSearchIndexCacheManager(s.mcm, s.sca)
+	err := sicm.SetCache(context.Background())
+
+	version, _ := sicm.GetVersion(context.Background())
+	idx, _ := sicm.GetCache(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.NotEmpty(s.T(), version)
+	assert.Equal(s.T(), version, idx.Version)
+	assert.Equal(s.T(), 2, len(idx.Entries))
+}
+
+func (s *SearchIndexCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsMissing() {
+	sicm := NewSearchIndexCacheManager(s.mcm, s.sca)
+	idx, err := sicm.GetCache(context.Background())
+
+	assert.Nil(s.T(), idx)
+	assert.Equal(s.T(), "redis: nil", err.Error())
+}
+
+func (s *SearchIndexCacheManagerTestSuite) TestGetCache_ReturnsError_WhenCacheIsInvalid() {
+	_ = s.sca.Set(context.Background(), "v1", "foo")
+	defer func() {
+		_ = s.sca.Delete(context.Background())
+	}()
+
+	sicm := NewSearchIndexCacheManager(s.mcm, s.sca)
+	idx, err := sicm.GetCache(context.Background())
+
+	assert.Nil(s.T(), idx)
+	assert.Equal(s.T(), "invalid search index cache", err.Error())
+}
+
+func (s *SearchIndexCacheManagerTestSuite) TestGetCache_ReturnsIndex_WhenCacheIsStored() {
+	ib, _ := json.Marshal(search.NewIndex("v1", getFakeMangaList().Mangas))
+	_ = s.sca.Set(context.Background(), "v1", string(ib))
+	defer func() {
+		_ = s.sca.Delete(context.Background())
+	}()
+
+	sicm := NewSearchIndexCacheManager(s.mcm, s.sca)
+	idx, err := sicm.GetCache(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "v1", idx.Version)
+	assert.True(s.T(), len(idx.Entries) > 0)
+}
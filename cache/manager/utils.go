@@ -0,0 +1,15 @@
+package manager
+
+import (
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/config"
+)
+
+func newLocalCache() cache.LocalCache {
+	lc := config.LocalCache()
+	return cache.NewLocalCache(lc.MaxEntries, lc.MaxAge)
+}
+
+func newCodec() cache.Codec {
+	return cache.NewCodec()
+}
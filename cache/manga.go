@@ -1,10 +1,10 @@
 package cache
 
 import (
-	"time"
+	"context"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
-	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/go-redis/redis"
 )
@@ -14,35 +14,68 @@ type mangaCache struct {
 }
 
 type MangaCache interface {
-	Set(value string) error
-	Get() (string, error)
-	Delete() error
+	Set(ctx context.Context, value string) error
+	Get(ctx context.Context) (string, error)
+	GetEntry(ctx context.Context) (Entry, error)
+	LockRefresh(ctx context.Context) (bool, error)
+	Delete(ctx context.Context) error
+	Key() string
 }
 
 const mangaCacheKey = "MangasCache"
 
-func (c *mangaCache) Set(value string) error {
-	err := c.redisClient.Set(mangaCacheKey, value, time.Duration(constants.MangaCacheExpirationInMn)*time.Minute).Err()
+func (c *mangaCache) Set(ctx context.Context, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := setEntry(ctx, c.redisClient, mangaCacheKey, value, config.CacheTTLs().Manga)
 	if err != nil {
 		logger.Errorf("Failed to set %s - %s", mangaCacheKey, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, mangaCacheKey)
+	return nil
 }
 
-func (c *mangaCache) Get() (string, error) {
-	value, err := c.redisClient.Get(mangaCacheKey).Result()
+func (c *mangaCache) Get(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	value, err := c.redisClient.WithContext(ctx).Get(mangaCacheKey).Result()
 	if err != nil {
 		logger.Errorf("Failed to get %s - %s", mangaCacheKey, err)
 	}
 	return value, err
 }
 
-func (c *mangaCache) Delete() error {
-	err := c.redisClient.Del(mangaCacheKey).Err()
+func (c *mangaCache) GetEntry(ctx context.Context) (Entry, error) {
+	return getEntry(ctx, c.redisClient, mangaCacheKey)
+}
+
+func (c *mangaCache) LockRefresh(ctx context.Context) (bool, error) {
+	return lockRefresh(ctx, c.redisClient, mangaCacheKey)
+}
+
+func (c *mangaCache) Delete(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := deleteEntry(ctx, c.redisClient, mangaCacheKey)
 	if err != nil {
 		logger.Errorf("Failed to delete %s - %s", mangaCacheKey, err)
+		return err
 	}
-	return err
+
+	publishInvalidation(ctx, c.redisClient, mangaCacheKey)
+	return nil
+}
+
+func (c *mangaCache) Key() string {
+	return mangaCacheKey
 }
 
 func NewMangaCache() *mangaCache {
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func TestMangaCacheTestSuite(t *testing.T) {
 
 func (s *MangaCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
 	value := "lorem ipsum"
-	err := s.c.Set(value)
+	err := s.c.Set(context.Background(), value)
 	assert.Nil(s.T(), err)
 
 	result, _ := s.c.redisClient.Get(mangaCacheKey).Result()
@@ -42,7 +43,7 @@ func (s *MangaCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
 }
 
 func (s *MangaCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
-	val, err := s.c.Get()
+	val, err := s.c.Get(context.Background())
 
 	assert.Equal(s.T(), "", val)
 	assert.Equal(s.T(), "redis: nil", err.Error())
@@ -50,7 +51,7 @@ func (s *MangaCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
 
 func (s *MangaCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 	s.c.redisClient.Set(mangaCacheKey, "lorem ipsum", 5*time.Second)
-	val, err := s.c.Get()
+	val, err := s.c.Get(context.Background())
 
 	assert.Equal(s.T(), "lorem ipsum", val)
 	assert.Nil(s.T(), err)
@@ -59,16 +60,92 @@ func (s *MangaCacheTestSuite) TestGet_ReturnsValue_WhenKeyExists() {
 }
 
 func (s *MangaCacheTestSuite) TestDelete_WhenKeyIsMissing() {
-	err := s.c.Delete()
+	err := s.c.Delete(context.Background())
 
 	assert.Nil(s.T(), err)
 }
 
 func (s *MangaCacheTestSuite) TestDelete_WhenKeyExists() {
 	s.c.redisClient.Set(mangaCacheKey, "lorem ipsum", 5*time.Second)
-	err := s.c.Delete()
+	err := s.c.Delete(context.Background())
 	val, _ := s.c.redisClient.Get(mangaCacheKey).Result()
 
 	assert.Nil(s.T(), err)
 	assert.Empty(s.T(), val)
 }
+
+func (s *MangaCacheTestSuite) TestGet_ReturnsError_WhenContextIsCancelled() {
+	s.c.redisClient.Set(mangaCacheKey, "lorem ipsum", 5*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	val, err := s.c.Get(ctx)
+
+	assert.Equal(s.T(), context.Canceled, err)
+	assert.Equal(s.T(), "", val)
+
+	s.c.redisClient.Del(mangaCacheKey)
+}
+
+func (s *MangaCacheTestSuite) TestGetEntry_ReturnsValueAndTTL_WhenKeyExists() {
+	s.c.redisClient.Set(mangaCacheKey, "lorem ipsum", time.Hour)
+	entry, err := s.c.GetEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "lorem ipsum", entry.Value)
+	assert.True(s.T(), entry.TTL > 0 && entry.TTL <= time.Hour)
+
+	s.c.redisClient.Del(mangaCacheKey)
+}
+
+func (s *MangaCacheTestSuite) TestGetEntry_ReturnsWriteTimes_WhenValueIsSet() {
+	before := time.Now()
+	_ = s.c.Set(context.Background(), "lorem ipsum")
+	defer func() {
+		_ = s.c.Delete(context.Background())
+	}()
+
+	entry, err := s.c.GetEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "lorem ipsum", entry.Value)
+	assert.False(s.T(), entry.StoredAt.Before(before))
+	assert.Equal(s.T(), config.CacheTTLs().Manga.Expiration, entry.StaleAt.Sub(entry.StoredAt))
+}
+
+func (s *MangaCacheTestSuite) TestGetEntry_ReturnsNoWriteTimes_WhenValueWasStoredWithoutThem() {
+	s.c.redisClient.Set(mangaCacheKey, "lorem ipsum", time.Hour)
+	defer s.c.redisClient.Del(mangaCacheKey)
+
+	entry, err := s.c.GetEntry(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), entry.StoredAt.IsZero())
+	assert.True(s.T(), entry.StaleAt.IsZero())
+}
+
+func (s *MangaCacheTestSuite) TestDelete_RemovesWriteTimes() {
+	_ = s.c.Set(context.Background(), "lorem ipsum")
+	err := s.c.Delete(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), int64(0), s.c.redisClient.Exists(generateEntryTimesKey(mangaCacheKey)).Val())
+}
+
+func (s *MangaCacheTestSuite) TestGetEntry_ReturnsError_WhenKeyIsMissing() {
+	_, err := s.c.GetEntry(context.Background())
+
+	assert.Equal(s.T(), "redis: nil", err.Error())
+}
+
+func (s *MangaCacheTestSuite) TestLockRefresh_ReturnsTrueOnlyOnce() {
+	defer s.c.redisClient.Del("RefreshLock|" + mangaCacheKey)
+
+	first, err := s.c.LockRefresh(context.Background())
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), first)
+
+	second, err := s.c.LockRefresh(context.Background())
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), second)
+}
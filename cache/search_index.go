@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+type searchIndexCache struct {
+	redisClient *redis.Client
+}
+
+type SearchIndexCache interface {
+	Set(ctx context.Context, version, value string) error
+	Get(ctx context.Context) (string, error)
+	GetVersion(ctx context.Context) (string, error)
+	LockRefresh(ctx context.Context) (bool, error)
+	Delete(ctx context.Context) error
+}
+
+const (
+	searchIndexCacheKey        = "SearchIndexCache"
+	searchIndexVersionCacheKey = "SearchIndexCache|version"
+)
+
+func (c *searchIndexCache) Set(ctx context.Context, version, value string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	expiration := time.Duration(constants.SearchIndexCacheExpirationInMn) * time.Minute
+	_, err := c.redisClient.WithContext(ctx).TxPipelined(func(pipe redis.Pipeliner) error {
+		pipe.Set(searchIndexCacheKey, value, expiration)
+		pipe.Set(searchIndexVersionCacheKey, version, expiration)
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Failed to set %s - %s", searchIndexCacheKey, err)
+	}
+	return err
+}
+
+func (c *searchIndexCache) Get(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	value, err := c.redisClient.WithContext(ctx).Get(searchIndexCacheKey).Result()
+	if err != nil {
+		logger.Errorf("Failed to get %s - %s", searchIndexCacheKey, err)
+	}
+	return value, err
+}
+
+func (c *searchIndexCache) GetVersion(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	value, err := c.redisClient.WithContext(ctx).Get(searchIndexVersionCacheKey).Result()
+	if err != nil {
+		logger.Errorf("Failed to get %s - %s", searchIndexVersionCacheKey, err)
+	}
+	return value, err
+}
+
+func (c *searchIndexCache) LockRefresh(ctx context.Context) (bool, error) {
+	return lockRefresh(ctx, c.redisClient, searchIndexCacheKey)
+}
+
+func (c *searchIndexCache) Delete(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	err := c.redisClient.WithContext(ctx).Del(searchIndexCacheKey, searchIndexVersionCacheKey).Err()
+	if err != nil {
+		logger.Errorf("Failed to delete %s - %s", searchIndexCacheKey, err)
+	}
+	return err
+}
+
+func NewSearchIndexCache() *searchIndexCache {
+	return &searchIndexCache{
+		redisClient: appcontext.GetRedisClient(),
+	}
+}
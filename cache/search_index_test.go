@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchIndexCacheTestSuite struct {
+	suite.Suite
+	c *searchIndexCache
+}
+
+func (s *SearchIndexCacheTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *SearchIndexCacheTestSuite) SetupTest() {
+	s.c = NewSearchIndexCache()
+}
+
+func TestSearchIndexCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchIndexCacheTestSuite))
+}
+
+func (s *SearchIndexCacheTestSuite) TestLockRefresh_ReturnsTrueOnlyOnce() {
+	defer s.c.redisClient.Del("RefreshLock|" + searchIndexCacheKey)
+
+	first, err := s.c.LockRefresh(context.Background())
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), first)
+
+	second, err := s.c.LockRefresh(context.Background())
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), second)
+}
+
+func (s *SearchIndexCacheTestSuite) TestSet_ReturnsNilError_WhenValueStored() {
+	err := s.c.Set(context.Background(), "v1", "lorem ipsum")
+	assert.Nil(s.T(), err)
+
+	value, _ := s.c.redisClient.Get(searchIndexCacheKey).Result()
+	version, _ := s.c.redisClient.Get(searchIndexVersionCacheKey).Result()
+	assert.Equal(s.T(), "lorem ipsum", value)
+	assert.Equal(s.T(), "v1", version)
+
+	s.c.redisClient.Del(searchIndexCacheKey, searchIndexVersionCacheKey)
+}
+
+func (s *SearchIndexCacheTestSuite) TestGet_ReturnsError_WhenKeyIsMissing() {
+	val, err := s.c.Get(context.Background())
+
+	assert.Equal(s.T(), "", val)
+	assert.Equal(s.T(), "redis: nil", err.Error())
+}
+
+func (s *SearchIndexCacheTestSuite) TestGetVersion_ReturnsValue_WhenKeyExists() {
+	s.c.redisClient.Set(searchIndexVersionCacheKey, "v1", 5*time.Second)
+	val, err := s.c.GetVersion(context.Background())
+
+	assert.Equal(s.T(), "v1", val)
+	assert.Nil(s.T(), err)
+
+	s.c.redisClient.Del(searchIndexVersionCacheKey)
+}
+
+func (s *SearchIndexCacheTestSuite) TestDelete_WhenKeysExist() {
+	_ = s.c.Set(context.Background(), "v1", "lorem ipsum")
+	err := s.c.Delete(context.Background())
+	val, _ := s.c.redisClient.Get(searchIndexCacheKey).Result()
+	version, _ := s.c.redisClient.Get(searchIndexVersionCacheKey).Result()
+
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), val)
+	assert.Empty(s.T(), version)
+}
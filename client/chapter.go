@@ -1,25 +1,23 @@
 package client
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"time"
 
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
-	"github.com/gojektech/heimdall"
 )
 
 type ChapterClient interface {
-	GetChapterList(titleID string) (*domain.ChapterListResponse, error)
+	GetChapterList(ctx context.Context, titleID string) (*domain.ChapterListResponse, error)
 }
 
 type chapterClient struct {
-	httpClient heimdall.Client
+	origin   *originClient
+	fallback ChapterClient
 }
 
 func buildChapterListEndpoint(titleID string) string {
@@ -28,38 +26,24 @@ func buildChapterListEndpoint(titleID string) string {
 	return config.BaseURL() + "/official/2016/chapter_list.php" + qParam
 }
 
-func (c *chapterClient) GetChapterList(titleID string) (*domain.ChapterListResponse, error) {
-	res, err := c.httpClient.Get(buildChapterListEndpoint(titleID), nil)
-	if err != nil {
-		errMsg := constants.ServerError + " " + err.Error()
-		return nil, errors.New(errMsg)
-	}
-
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if string(body) == constants.NullText {
-		logger.Error("Origin response body is null")
-		return nil, errors.New(constants.InvalidJSONResponseError)
-	}
-
+func (c *chapterClient) GetChapterList(ctx context.Context, titleID string) (*domain.ChapterListResponse, error) {
 	var response *domain.ChapterListResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		logger.Errorf("Error when unmarshalling origin response: %s", err.Error())
-		return nil, errors.New(constants.InvalidJSONResponseError)
+	if err := c.origin.getJSON(ctx, buildChapterListEndpoint(titleID), &response); err != nil {
+		if c.fallback != nil && mErr.IsOriginError(err) {
+			logger.Warnf("Falling back to origin website after GetChapterListCommand failed: %s", err.Error())
+			return c.fallback.GetChapterList(ctx, titleID)
+		}
+		return nil, err
 	}
-	return response, err
+	return response, nil
 }
 
 func NewChapterClient() *chapterClient {
-	hc := config.HystrixConfig()
-	timeout := time.Duration(hc.Timeout) * time.Millisecond
-
-	httpClient := heimdall.NewHystrixHTTPClient(timeout, heimdall.NewHystrixConfig(constants.GetChapterListCommand, hc))
-	return &chapterClient{
-		httpClient: httpClient,
+	c := &chapterClient{
+		origin: newOriginClient(constants.MangacanSource, constants.GetChapterListCommand),
+	}
+	if config.HTMLFallbackEnabled(constants.GetChapterListCommand) {
+		c.fallback = NewHTMLScraper()
 	}
+	return c
 }
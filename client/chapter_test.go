@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -44,7 +45,7 @@ func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenCallTimesOu
 	}()
 
 	cc := NewChapterClient()
-	res, err := cc.GetChapterList(titleID)
+	res, err := cc.GetChapterList(context.Background(), titleID)
 
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), res)
@@ -53,10 +54,11 @@ func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenCallTimesOu
 func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenOriginServerReturns5xxStatusCode() {
 	defer gock.Off()
 	gock.New(buildChapterListEndpoint(titleID)).
+		Persist().
 		Reply(http.StatusInternalServerError)
 
 	cc := NewChapterClient()
-	res, err := cc.GetChapterList(titleID)
+	res, err := cc.GetChapterList(context.Background(), titleID)
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), "origin server error: Server is down: returned status code: 500", err.Error())
@@ -66,11 +68,12 @@ func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenOriginServe
 func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenOriginServerReturnsNull() {
 	defer gock.Off()
 	gock.New(buildChapterListEndpoint(titleID)).
+		Persist().
 		Reply(http.StatusOK).
 		Body(ioutil.NopCloser(strings.NewReader(constants.NullText)))
 
 	cc := NewChapterClient()
-	res, err := cc.GetChapterList(titleID)
+	res, err := cc.GetChapterList(context.Background(), titleID)
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -84,7 +87,7 @@ func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsError_WhenOriginServe
 		Body(ioutil.NopCloser(strings.NewReader("some error")))
 
 	cc := NewChapterClient()
-	res, err := cc.GetChapterList(titleID)
+	res, err := cc.GetChapterList(context.Background(), titleID)
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -98,7 +101,7 @@ func (s *ChapterClientTestSuite) TestGetChapterList_ReturnsSuccessfulResponse()
 		Body(ioutil.NopCloser(strings.NewReader(`{"komik":[{"hidden_chapter":686,"judul":"Bleach 686 - Death And Strawberry (tamat)","hidden_komik":"bleach","waktu":"2016-08-18 18:59:58"}]}`)))
 
 	cc := NewChapterClient()
-	res, err := cc.GetChapterList(titleID)
+	res, err := cc.GetChapterList(context.Background(), titleID)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(res.Chapters) > 0)
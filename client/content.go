@@ -1,65 +1,49 @@
 package client
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"time"
 
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
-	"github.com/gojektech/heimdall"
 )
 
 type ContentClient interface {
-	GetContentList(titleID string, chapter float32) (*domain.ContentListResponse, error)
+	GetContentList(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error)
 }
 
 type contentClient struct {
-	httpClient heimdall.Client
+	origin   *originClient
+	fallback ContentClient
 }
 
-func buildContentListEndpoint(titleID string, chapter float32) string {
-	qParams := "?manga=%s&chapter=%f"
+func buildContentListEndpoint(titleID string, chapter domain.ChapterID) string {
+	qParams := "?manga=%s&chapter=%s"
 	qParams = fmt.Sprintf(qParams, titleID, chapter)
 	return config.BaseURL() + "/official/2016/image_list.php" + qParams
 }
 
-func (c *contentClient) GetContentList(titleID string, chapter float32) (*domain.ContentListResponse, error) {
-	res, err := c.httpClient.Get(buildContentListEndpoint(titleID, chapter), nil)
-	if err != nil {
-		errMsg := constants.ServerError + " " + err.Error()
-		return nil, errors.New(errMsg)
-	}
-
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if string(body) == constants.NullText {
-		logger.Error("Origin response body is null")
-		return nil, errors.New(constants.InvalidJSONResponseError)
-	}
-
+func (c *contentClient) GetContentList(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error) {
 	var response *domain.ContentListResponse
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		logger.Errorf("Error when unmarshalling origin response: %s", err.Error())
-		return nil, errors.New(constants.InvalidJSONResponseError)
+	if err := c.origin.getJSON(ctx, buildContentListEndpoint(titleID, chapter), &response); err != nil {
+		if c.fallback != nil && mErr.IsOriginError(err) {
+			logger.Warnf("Falling back to origin website after GetContentListCommand failed: %s", err.Error())
+			return c.fallback.GetContentList(ctx, titleID, chapter)
+		}
+		return nil, err
 	}
-	return response, err
+	return response, nil
 }
 
 func NewContentClient() *contentClient {
-	hc := config.HystrixConfig()
-	timeout := time.Duration(hc.Timeout) * time.Millisecond
-
-	httpClient := heimdall.NewHystrixHTTPClient(timeout, heimdall.NewHystrixConfig(constants.GetContentListCommand, hc))
-	return &contentClient{
-		httpClient: httpClient,
+	c := &contentClient{
+		origin: newOriginClient(constants.MangacanSource, constants.GetContentListCommand),
+	}
+	if config.HTMLFallbackEnabled(constants.GetContentListCommand) {
+		c.fallback = NewHTMLScraper()
 	}
+	return c
 }
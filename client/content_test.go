@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -31,6 +32,11 @@ func TestContentClientTestSuite(t *testing.T) {
 	suite.Run(t, new(ContentClientTestSuite))
 }
 
+func (s *ContentClientTestSuite) TestBuildContentListEndpoint_UsesCanonicalChapter() {
+	assert.Equal(s.T(), config.BaseURL()+"/official/2016/image_list.php?manga=bleach&chapter=1001.55",
+		buildContentListEndpoint("bleach", "1001.55"))
+}
+
 func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenCallTimesOut() {
 	ht := os.Getenv("HYSTRIX_TIMEOUT_MS")
 
@@ -42,7 +48,7 @@ func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenCallTimesOu
 	}()
 
 	cc := NewContentClient()
-	res, err := cc.GetContentList("bleach", 657.0)
+	res, err := cc.GetContentList(context.Background(), "bleach", "657")
 
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), res)
@@ -50,11 +56,12 @@ func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenCallTimesOu
 
 func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServerReturns5xxStatusCode() {
 	defer gock.Off()
-	gock.New(buildContentListEndpoint("bleach", 657.0)).
+	gock.New(buildContentListEndpoint("bleach", "657")).
+		Persist().
 		Reply(http.StatusInternalServerError)
 
 	cc := NewContentClient()
-	res, err := cc.GetContentList("bleach", 657.0)
+	res, err := cc.GetContentList(context.Background(), "bleach", "657")
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), "origin server error: Server is down: returned status code: 500", err.Error())
@@ -63,12 +70,13 @@ func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServe
 
 func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServerReturnsNull() {
 	defer gock.Off()
-	gock.New(buildContentListEndpoint("bleach", 657.0)).
+	gock.New(buildContentListEndpoint("bleach", "657")).
+		Persist().
 		Reply(http.StatusOK).
 		Body(ioutil.NopCloser(strings.NewReader(constants.NullText)))
 
 	cc := NewContentClient()
-	res, err := cc.GetContentList("bleach", 657.0)
+	res, err := cc.GetContentList(context.Background(), "bleach", "657")
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -77,12 +85,12 @@ func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServe
 
 func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServerReturnsBrokenJSONResponse() {
 	defer gock.Off()
-	gock.New(buildContentListEndpoint("bleach", 657.0)).
+	gock.New(buildContentListEndpoint("bleach", "657")).
 		Reply(http.StatusOK).
 		Body(ioutil.NopCloser(strings.NewReader("some error")))
 
 	cc := NewContentClient()
-	res, err := cc.GetContentList("bleach", 657.0)
+	res, err := cc.GetContentList(context.Background(), "bleach", "657")
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -91,12 +99,12 @@ func (s *ContentClientTestSuite) TestGetContentList_ReturnsError_WhenOriginServe
 
 func (s *ContentClientTestSuite) TestGetContentList_ReturnsSuccessfulResponse() {
 	defer gock.Off()
-	gock.New(buildContentListEndpoint("bleach", 657.0)).
+	gock.New(buildContentListEndpoint("bleach", "657")).
 		Reply(http.StatusOK).
 		Body(ioutil.NopCloser(strings.NewReader(`{"chapter":[{"url":"http://mangacanblog.com/mangas/bleach/657 - thunder god 2/mangacanblogcom_bleach_657_01.jpg","page":1},{"url":"http://mangacanblog.com/mangas/bleach/657 - thunder god 2/mangacanblogcom_bleach_657_02.jpg","page":2},{"url":"http://mangacanblog.com/mangas/bleach/657 - thunder god 2/mangacanblogcom_bleach_657_03.jpg","page":3}]}`)))
 
 	cc := NewContentClient()
-	res, err := cc.GetContentList("bleach", 657.0)
+	res, err := cc.GetContentList(context.Background(), "bleach", "657")
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(res.Contents) > 0)
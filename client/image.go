@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+// sniffLen is the number of bytes http.DetectContentType looks at.
+const sniffLen = 512
+
+type ImageClient interface {
+	GetImage(ctx context.Context, host, path string) (io.ReadCloser, error)
+	GetImageMeta(ctx context.Context, imageURL string) (*domain.ImageMeta, error)
+}
+
+type imageClient struct {
+	origin *originClient
+}
+
+// buildImageEndpoint points at path on an image host, using the scheme of
+// the origin.
+func buildImageEndpoint(host, path string) string {
+	u := &url.URL{Host: host, Path: "/" + strings.TrimPrefix(path, "/")}
+	if origin, err := url.Parse(config.BaseURL()); err == nil {
+		u.Scheme = origin.Scheme
+	}
+	return u.String()
+}
+
+// imageBody is an origin image whose first bytes were already read to check
+// its type.
+type imageBody struct {
+	io.Reader
+	io.Closer
+}
+
+// GetImage opens an image on host to be streamed. Its first bytes are sniffed
+// so pages sent instead of images are rejected before anything is served,
+// and reading it fails past the origin response size limit. The returned
+// body must be closed.
+func (c *imageClient) GetImage(ctx context.Context, host, path string) (io.ReadCloser, error) {
+	body, err := c.origin.openBody(ctx, buildImageEndpoint(host, path))
+	if err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReaderSize(body, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		body.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if _, ok := err.(*mErr.OriginResponseTooLargeError); ok {
+			return nil, err
+		}
+		return nil, mErr.NewOriginUnavailableError(err.Error())
+	}
+
+	if contentType := http.DetectContentType(head); !strings.HasPrefix(contentType, "image/") {
+		body.Close()
+		logger.Errorf("Origin returned %s instead of an image for %s", contentType, path)
+		return nil, mErr.NewInvalidOriginResponseError()
+	}
+	return imageBody{Reader: br, Closer: body}, nil
+}
+
+// GetImageMeta downloads the image at imageURL, which may live on any host,
+// and decodes only its header to get the dimensions.
+func (c *imageClient) GetImageMeta(ctx context.Context, imageURL string) (*domain.ImageMeta, error) {
+	body, err := c.origin.getBody(ctx, imageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(body))
+	if err != nil {
+		logger.Errorf("Failed to decode image header of %s: %s", imageURL, err.Error())
+		return nil, mErr.NewInvalidOriginResponseError()
+	}
+	logger.Debugf("Probed %s image %s: %dx%d", format, imageURL, cfg.Width, cfg.Height)
+
+	return &domain.ImageMeta{
+		Width:  cfg.Width,
+		Height: cfg.Height,
+		Size:   int64(len(body)),
+	}, nil
+}
+
+// NewImageClient sends the origin website as Referer unless one is
+// configured, since the image hosts reject hotlinked requests.
+func NewImageClient() *imageClient {
+	origin := newOriginClient(constants.MangacanSource, constants.GetImageCommand)
+	if origin.headers.Get("Referer") == "" {
+		origin.headers.Set("Referer", config.BaseURL()+"/")
+	}
+	return &imageClient{origin: origin}
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageClientTestSuite struct {
+	suite.Suite
+}
+
+func (s *ImageClientTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestImageClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageClientTestSuite))
+}
+
+var fakePNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func getOriginHost() string {
+	u, _ := url.Parse(config.BaseURL())
+	return u.Host
+}
+
+func (s *ImageClientTestSuite) TestBuildImageEndpoint_EscapesPath() {
+	assert.Equal(s.T(), config.BaseURL()+"/mangas/bleach/686/bleach%2001.jpg",
+		buildImageEndpoint(getOriginHost(), "/mangas/bleach/686/bleach 01.jpg"))
+}
+
+func (s *ImageClientTestSuite) TestBuildImageEndpoint_UsesImageHost() {
+	u, _ := url.Parse(config.BaseURL())
+
+	assert.Equal(s.T(), u.Scheme+"://www.foo.com/mangas/bleach/686/01.jpg",
+		buildImageEndpoint("www.foo.com", "mangas/bleach/686/01.jpg"))
+}
+
+func (s *ImageClientTestSuite) TestGetImage_ReturnsImage_AndSendsReferer() {
+	defer gock.Off()
+	gock.New(config.BaseURL()).
+		Get("/mangas/bleach/686/01.png").
+		MatchHeader("Referer", "^"+config.BaseURL()+"/$").
+		Reply(http.StatusOK).
+		Body(bytes.NewReader(fakePNG))
+
+	res, err := NewImageClient().GetImage(context.Background(), getOriginHost(), "mangas/bleach/686/01.png")
+	s.Require().NoError(err)
+	defer res.Close()
+
+	b, err := ioutil.ReadAll(res)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), fakePNG, b)
+	assert.True(s.T(), gock.IsDone())
+}
+
+func (s *ImageClientTestSuite) TestGetImage_FailsRead_WhenImageIsOverSizeLimit() {
+	_ = os.Setenv("ORIGIN_MAX_RESPONSE_BYTES", "1024")
+	config.Load()
+	defer func() {
+		_ = os.Unsetenv("ORIGIN_MAX_RESPONSE_BYTES")
+		config.Load()
+	}()
+
+	defer gock.Off()
+	gock.New(config.BaseURL()).
+		Get("/mangas/bleach/686/04.png").
+		Reply(http.StatusOK).
+		Body(bytes.NewReader(append(fakePNG, make([]byte, 2048)...)))
+
+	res, err := NewImageClient().GetImage(context.Background(), getOriginHost(), "mangas/bleach/686/04.png")
+	s.Require().NoError(err)
+	defer res.Close()
+
+	b, err := ioutil.ReadAll(res)
+	assert.Equal(s.T(), mErr.NewOriginResponseTooLargeError(1024), err)
+	assert.Equal(s.T(), 1024, len(b))
+}
+
+func (s *ImageClientTestSuite) TestGetImage_ReturnsError_WhenBodyIsNotAnImage() {
+	defer gock.Off()
+	gock.New(config.BaseURL()).
+		Get("/mangas/bleach/686/02.png").
+		Reply(http.StatusOK).
+		BodyString("<html><body>hotlink is not allowed</body></html>")
+
+	res, err := NewImageClient().GetImage(context.Background(), getOriginHost(), "mangas/bleach/686/02.png")
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), mErr.NewInvalidOriginResponseError(), err)
+}
+
+func (s *ImageClientTestSuite) TestGetImage_ReturnsStatusError_WhenImageIsMissing() {
+	defer gock.Off()
+	gock.New(config.BaseURL()).
+		Get("/mangas/bleach/686/03.png").
+		Reply(http.StatusNotFound)
+
+	res, err := NewImageClient().GetImage(context.Background(), getOriginHost(), "mangas/bleach/686/03.png")
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), mErr.NewOriginStatusError(http.StatusNotFound), err)
+}
+
+func (s *ImageClientTestSuite) TestGetImageMeta_ReturnsDimensionsAndSize() {
+	var buf bytes.Buffer
+	s.Require().NoError(png.Encode(&buf, image.NewGray(image.Rect(0, 0, 8, 20))))
+
+	defer gock.Off()
+	gock.New("http://foo.com").
+		Get("/mangas/bleach 686/01.png").
+		Reply(http.StatusOK).
+		Body(bytes.NewReader(buf.Bytes()))
+
+	res, err := NewImageClient().GetImageMeta(context.Background(), "http://foo.com/mangas/bleach 686/01.png")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), &domain.ImageMeta{Width: 8, Height: 20, Size: int64(buf.Len())}, res)
+}
+
+func (s *ImageClientTestSuite) TestGetImageMeta_ReturnsError_WhenImageCannotBeDecoded() {
+	defer gock.Off()
+	gock.New("http://foo.com").
+		Get("/01.png").
+		Reply(http.StatusOK).
+		BodyString("not an image")
+
+	res, err := NewImageClient().GetImageMeta(context.Background(), "http://foo.com/01.png")
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), mErr.NewInvalidOriginResponseError(), err)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -43,7 +44,7 @@ func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenCallTimesOut()
 	}()
 
 	mc := NewMangaClient()
-	res, err := mc.GetMangaList()
+	res, err := mc.GetMangaList(context.Background())
 
 	assert.NotNil(s.T(), err)
 	assert.Nil(s.T(), res)
@@ -52,10 +53,11 @@ func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenCallTimesOut()
 func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenOriginServerReturns5xxStatusCode() {
 	defer gock.Off()
 	gock.New(buildMangaListEndpoint()).
+		Persist().
 		Reply(http.StatusInternalServerError)
 
 	mc := NewMangaClient()
-	res, err := mc.GetMangaList()
+	res, err := mc.GetMangaList(context.Background())
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), "origin server error: Server is down: returned status code: 500", err.Error())
@@ -69,7 +71,7 @@ func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenOriginServerRet
 		Body(ioutil.NopCloser(strings.NewReader("some error")))
 
 	mc := NewMangaClient()
-	res, err := mc.GetMangaList()
+	res, err := mc.GetMangaList(context.Background())
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -79,11 +81,12 @@ func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenOriginServerRet
 func (s *MangaClientTestSuite) TestGetMangaList_ReturnsError_WhenOriginServerReturnsNull() {
 	defer gock.Off()
 	gock.New(buildMangaListEndpoint()).
+		Persist().
 		Reply(http.StatusOK).
 		Body(ioutil.NopCloser(strings.NewReader(constants.NullText)))
 
 	mc := NewMangaClient()
-	res, err := mc.GetMangaList()
+	res, err := mc.GetMangaList(context.Background())
 
 	assert.NotNil(s.T(), err)
 	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
@@ -97,7 +100,7 @@ func (s *MangaClientTestSuite) TestGetMangaList_ReturnsSuccessfulResponse() {
 		Body(ioutil.NopCloser(strings.NewReader(`{"komik":[{"id":"1","judul":"Boku No Hero Academia","hidden_komik":"boku_no_hero_academia","icon_komik":"http://www.mangacanblog.com/official/img/boku_no_hero_academia.jpg","hiddenNewChapter":"224","lastModified":"2019-04-12 15:28:03","genre":"Action, Adventure, Comedy, Shounen, School Life, Sci-Fi, Supernatural","nama_lain":"Boku No Hero Academia","pengarang":"Horikoshi Kouhei","status":"OnGoing","published":"2014","summary":"Cerita ditetapkan di hari modern, kecuali orang-orang dengan kekuatan spesial di seluruh dunia. Anak laki-laki bernama Izuku Modoriya tidak memiliki kekuatan, tapi dia masih bermimpi, Penasaran? simak kisahnya hanya di mangacanblog.com."}]}`)))
 
 	mc := NewMangaClient()
-	res, err := mc.GetMangaList()
+	res, err := mc.GetMangaList(context.Background())
 
 	a, _ := json.Marshal(res)
 	println(string(a))
@@ -0,0 +1,27 @@
+package client
+
+import "github.com/bigscreen/mangindo-feeder/constants"
+
+func init() {
+	RegisterSource(constants.MangacanSource, func() Source {
+		return NewMangacanSource()
+	})
+}
+
+type mangacanSource struct {
+	*mangaClient
+	*chapterClient
+	*contentClient
+}
+
+func (s *mangacanSource) Name() string {
+	return constants.MangacanSource
+}
+
+func NewMangacanSource() *mangacanSource {
+	return &mangacanSource{
+		mangaClient:   NewMangaClient(),
+		chapterClient: NewChapterClient(),
+		contentClient: NewContentClient(),
+	}
+}
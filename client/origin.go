@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/gojektech/heimdall"
+)
+
+// maxDrainSize bounds how much of an unread body is discarded before closing
+// it, so a huge error page does not hold the connection for long.
+const maxDrainSize = 64 << 10
+
+// originClient is the request core shared by every origin endpoint. It sends
+// the configured headers, retries through the retrier, keeps each attempt
+// within the shared rate limit, enforces the response size limit and maps
+// failures into the typed origin errors.
+type originClient struct {
+	command         string
+	httpClient      heimdall.Client
+	retrier         *retrier
+	limiter         *rateLimiter
+	headers         http.Header
+	maxResponseSize int64
+}
+
+// getBody fetches url and returns its non-empty body.
+func (c *originClient) getBody(ctx context.Context, url string) ([]byte, error) {
+	var body []byte
+	err := c.retrier.do(ctx, func() (err error) {
+		body, err = c.fetch(ctx, url)
+		return err
+	})
+	return body, err
+}
+
+// openBody fetches url and returns its body unread, for responses that are
+// streamed rather than buffered. Only getting the response is retried.
+// Reading the body fails once it goes over the response size limit, and the
+// caller must close it.
+func (c *originClient) openBody(ctx context.Context, url string) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	err := c.retrier.do(ctx, func() error {
+		res, err := c.open(ctx, url)
+		if err != nil {
+			return err
+		}
+		body = &limitedBody{body: res.Body, max: c.maxResponseSize}
+		return nil
+	})
+	return body, err
+}
+
+// getJSON fetches url and decodes the body into target, which must be a
+// pointer. The body is checked against target for schema drift first.
+func (c *originClient) getJSON(ctx context.Context, url string, target interface{}) error {
+	body, err := c.getBody(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	checkSchema(ctx, c.command, body, target)
+
+	if err := json.Unmarshal(body, target); err != nil {
+		logger.Errorf("Error when unmarshalling origin response: %s", err.Error())
+		return mErr.NewInvalidOriginResponseError()
+	}
+	return nil
+}
+
+func (c *originClient) fetch(ctx context.Context, url string) ([]byte, error) {
+	res, err := c.open(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(res.Body)
+
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, c.maxResponseSize+1))
+	if err != nil {
+		return nil, retryableError{mErr.NewOriginUnavailableError(err.Error())}
+	}
+	if int64(len(body)) > c.maxResponseSize {
+		return nil, mErr.NewOriginResponseTooLargeError(c.maxResponseSize)
+	}
+
+	if b := strings.TrimSpace(string(body)); b == "" || b == constants.NullText {
+		logger.Error("Origin response body is null")
+		return nil, retryableError{mErr.NewInvalidOriginResponseError()}
+	}
+
+	return body, nil
+}
+
+// open sends a request to url and returns the response of a 200, with its
+// body left to the caller.
+func (c *originClient) open(ctx context.Context, url string) (*http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = c.headers.Clone()
+
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		if res != nil {
+			closeBody(res.Body)
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		originErr := mErr.NewOriginUnavailableError(err.Error())
+		if isRetryableHystrixError(err) {
+			return nil, retryableError{originErr}
+		}
+		return nil, originErr
+	}
+
+	if res.StatusCode != http.StatusOK {
+		closeBody(res.Body)
+		return nil, mErr.NewOriginStatusError(res.StatusCode)
+	}
+	return res, nil
+}
+
+// limitedBody counts the bytes read from an origin body, and fails the read
+// that goes over max instead of cutting the body short silently.
+type limitedBody struct {
+	body io.ReadCloser
+	max  int64
+	n    int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	if b.n+int64(n) > b.max {
+		n = int(b.max - b.n)
+		b.n = b.max
+		return n, mErr.NewOriginResponseTooLargeError(b.max)
+	}
+	b.n += int64(n)
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	closeBody(b.body)
+	return nil
+}
+
+// contextDoer returns the context error itself when a request is cancelled
+// or its deadline passes, which hystrix records as a cancellation instead of
+// a failure of the origin.
+type contextDoer struct {
+	client heimdall.Doer
+}
+
+func (d contextDoer) Do(req *http.Request) (*http.Response, error) {
+	res, err := d.client.Do(req)
+	if err != nil && req.Context().Err() != nil {
+		return nil, req.Context().Err()
+	}
+	return res, err
+}
+
+func newHystrixClient(command string, timeout time.Duration, hc heimdall.HystrixCommandConfig) heimdall.Client {
+	client := heimdall.NewHystrixHTTPClient(timeout, heimdall.NewHystrixConfig(command, hc))
+	client.SetCustomHTTPClient(contextDoer{client: &http.Client{Timeout: timeout}})
+	return client
+}
+
+func closeBody(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(body, maxDrainSize))
+	_ = body.Close()
+}
+
+func buildOriginHeaders(cfg config.OriginConfig) http.Header {
+	headers := http.Header{}
+	for k, v := range cfg.ExtraHeaders {
+		headers.Set(k, v)
+	}
+	if cfg.UserAgent != "" {
+		headers.Set("User-Agent", cfg.UserAgent)
+	}
+	return headers
+}
+
+func newOriginClient(source, command string) *originClient {
+	hc := config.HystrixConfig()
+	timeout := time.Duration(hc.Timeout) * time.Millisecond
+
+	sourceCommand := getSourceCommand(source, command)
+	return &originClient{
+		command:         sourceCommand,
+		httpClient:      newHystrixClient(sourceCommand, timeout, hc),
+		retrier:         newRetrier(source, command),
+		limiter:         newRateLimiter(source, command),
+		headers:         buildOriginHeaders(config.Origin()),
+		maxResponseSize: config.Origin().MaxResponseSize,
+	}
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/afex/hystrix-go/hystrix"
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OriginClientTestSuite struct {
+	suite.Suite
+}
+
+func (s *OriginClientTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestOriginClientTestSuite(t *testing.T) {
+	suite.Run(t, new(OriginClientTestSuite))
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_SendsConfiguredHeaders() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").
+		MatchHeader("User-Agent", "^foo-agent$").
+		MatchHeader("Referer", "^http://foo.com/$").
+		Reply(http.StatusOK).BodyString(`{"foo":"bar"}`)
+
+	oc := buildOriginClient("OriginHeadersCommand", 1)
+	oc.headers = buildOriginHeaders(config.OriginConfig{
+		UserAgent:    "foo-agent",
+		ExtraHeaders: map[string]string{"Referer": "http://foo.com/", "User-Agent": "ignored"},
+	})
+
+	var res map[string]string
+	err := oc.getJSON(context.Background(), "http://foo.com/bar", &res)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), map[string]string{"foo": "bar"}, res)
+	assert.True(s.T(), gock.IsDone())
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_ReturnsStatusError_WhenOriginReturnsNon200() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusNotFound).BodyString("not found")
+
+	oc := buildOriginClient("OriginStatusCommand", 3)
+
+	var res map[string]string
+	err := oc.getJSON(context.Background(), "http://foo.com/bar", &res)
+
+	assert.Equal(s.T(), mErr.NewOriginStatusError(http.StatusNotFound), err)
+	assert.True(s.T(), gock.IsDone())
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_ReturnsUnavailableError_WhenOriginReturns5xx() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Persist().Reply(http.StatusBadGateway)
+
+	oc := buildOriginClient("OriginUnavailableCommand", 2)
+
+	var res map[string]string
+	err := oc.getJSON(context.Background(), "http://foo.com/bar", &res)
+
+	assert.IsType(s.T(), &mErr.OriginUnavailableError{}, err)
+	assert.True(s.T(), mErr.IsOriginError(err))
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_ReturnsTooLargeError_WhenBodyExceedsLimit() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString(`{"foo":"` + strings.Repeat("a", 32) + `"}`)
+
+	oc := buildOriginClient("OriginTooLargeCommand", 3)
+	oc.maxResponseSize = 16
+
+	var res map[string]string
+	err := oc.getJSON(context.Background(), "http://foo.com/bar", &res)
+
+	assert.Equal(s.T(), mErr.NewOriginResponseTooLargeError(16), err)
+	assert.Nil(s.T(), res)
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_ReturnsInvalidResponseError_WhenBodyIsNotJSON() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString("some error")
+
+	oc := buildOriginClient("OriginInvalidCommand", 3)
+
+	var res map[string]string
+	err := oc.getJSON(context.Background(), "http://foo.com/bar", &res)
+
+	assert.IsType(s.T(), &mErr.InvalidOriginResponseError{}, err)
+	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
+}
+
+func (s *OriginClientTestSuite) TestGetJSON_ReturnsContextError_WhenRequestIsCancelled() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	command := "OriginCancelledCommand"
+	oc := buildOriginClient(command, 3)
+	hystrix.ConfigureCommand(command, hystrix.CommandConfig{
+		Timeout:                1000,
+		RequestVolumeThreshold: 1,
+		ErrorPercentThreshold:  1,
+		SleepWindow:            5000,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var res map[string]string
+	err := oc.getJSON(ctx, server.URL, &res)
+
+	assert.Equal(s.T(), context.DeadlineExceeded, err)
+	assert.False(s.T(), mErr.IsOriginError(err))
+
+	time.Sleep(50 * time.Millisecond)
+	cb, _, _ := hystrix.GetCircuit(command)
+	assert.False(s.T(), cb.IsOpen())
+}
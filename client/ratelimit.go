@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+const (
+	rateLimitMetricsKey = "RateLimitMetrics"
+
+	rateLimitThrottled = "throttled"
+	rateLimitRejected  = "rejected"
+	rateLimitWaitMs    = "wait_ms"
+)
+
+// tokenBucketScript takes one token from the bucket in KEYS[1], refilled at
+// ARGV[1] tokens per second up to ARGV[2] tokens, at time ARGV[3] in ms. It
+// returns 0 when a token was taken, or how many ms to wait for the next one.
+// The clock is passed in by the caller since scripts calling TIME can not
+// write on older Redis versions.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+ts = math.max(ts, now)
+
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(ts))
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return wait
+`)
+
+// rateLimiter keeps an origin command within its budget across every API
+// and worker process by sharing one token bucket in Redis.
+type rateLimiter struct {
+	command     string
+	config      config.RateLimitConfig
+	redisClient *redis.Client
+}
+
+// RateLimitReport sums up how often calls of an origin command were held
+// back by the rate limiter, across all processes.
+type RateLimitReport struct {
+	Endpoint  string
+	Throttled int64
+	Rejected  int64
+	WaitTime  time.Duration
+}
+
+func generateRateLimitKey(command string) string {
+	return "RateLimit|" + command
+}
+
+// wait blocks until a token is taken, or returns RateLimitedError when the
+// budget is spent and the mode or the max wait does not allow waiting. Calls
+// are let through when Redis can not be reached.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	start := time.Now()
+	throttled := false
+	for {
+		wait, err := l.take(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			logger.Errorf("Failed to take rate limit token of %s, letting the call through: %s", l.command, err.Error())
+			return nil
+		}
+
+		if wait == 0 {
+			if throttled {
+				l.record(ctx, rateLimitThrottled, time.Since(start))
+			}
+			return nil
+		}
+
+		if l.config.Mode == constants.RateLimitModeReject || time.Since(start)+wait > l.config.MaxWait {
+			logger.Warnf("Rejecting %s call after rate limit was exceeded", l.command)
+			l.record(ctx, rateLimitRejected, time.Since(start))
+			return mErr.NewRateLimitedError(l.command)
+		}
+
+		throttled = true
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *rateLimiter) take(ctx context.Context) (time.Duration, error) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	wait, err := tokenBucketScript.Run(l.redisClient.WithContext(ctx), []string{generateRateLimitKey(l.command)},
+		l.config.RequestsPerSecond, l.config.Burst, now).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(wait) * time.Millisecond, nil
+}
+
+func (l *rateLimiter) record(ctx context.Context, outcome string, waited time.Duration) {
+	_, err := l.redisClient.WithContext(ctx).Pipelined(func(p redis.Pipeliner) error {
+		p.HIncrBy(rateLimitMetricsKey, l.command+"|"+outcome, 1)
+		p.HIncrBy(rateLimitMetricsKey, l.command+"|"+rateLimitWaitMs, int64(waited/time.Millisecond))
+		return nil
+	})
+	if err != nil {
+		logger.Errorf("Failed to record rate limit metrics of %s - %s", l.command, err)
+	}
+}
+
+func GetRateLimitReports(ctx context.Context) ([]RateLimitReport, error) {
+	fields, err := appcontext.GetRedisClient().WithContext(ctx).HGetAll(rateLimitMetricsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	byCommand := map[string]*RateLimitReport{}
+	for field, value := range fields {
+		i := strings.LastIndex(field, "|")
+		if i < 0 {
+			continue
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		command := field[:i]
+		r, ok := byCommand[command]
+		if !ok {
+			r = &RateLimitReport{Endpoint: command}
+			byCommand[command] = r
+		}
+		switch field[i+1:] {
+		case rateLimitThrottled:
+			r.Throttled = n
+		case rateLimitRejected:
+			r.Rejected = n
+		case rateLimitWaitMs:
+			r.WaitTime = time.Duration(n) * time.Millisecond
+		}
+	}
+
+	reports := []RateLimitReport{}
+	for _, r := range byCommand {
+		reports = append(reports, *r)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Endpoint < reports[j].Endpoint
+	})
+	return reports, nil
+}
+
+// newRateLimiter returns nil when rate limiting is disabled for command.
+func newRateLimiter(source, command string) *rateLimiter {
+	rc := config.RateLimitPolicy(command)
+	if !rc.Enabled {
+		return nil
+	}
+
+	return &rateLimiter{
+		command:     getSourceCommand(source, command),
+		config:      rc,
+		redisClient: appcontext.GetRedisClient(),
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimiterTestSuite struct {
+	suite.Suite
+}
+
+func (s *RateLimiterTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *RateLimiterTestSuite) TearDownTest() {
+	appcontext.GetRedisClient().Del(rateLimitMetricsKey)
+}
+
+func TestRateLimiterTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimiterTestSuite))
+}
+
+func buildRateLimiter(command, mode string, burst int, maxWait time.Duration) *rateLimiter {
+	appcontext.GetRedisClient().Del(generateRateLimitKey(command))
+	return &rateLimiter{
+		command: command,
+		config: config.RateLimitConfig{
+			Enabled:           true,
+			RequestsPerSecond: 20,
+			Burst:             burst,
+			Mode:              mode,
+			MaxWait:           maxWait,
+		},
+		redisClient: appcontext.GetRedisClient(),
+	}
+}
+
+func (s *RateLimiterTestSuite) TestWait_AllowsBurst_ThenWaitsForNextToken() {
+	l := buildRateLimiter("RateLimitWaitCommand", constants.RateLimitModeWait, 2, time.Second)
+
+	assert.Nil(s.T(), l.wait(context.Background()))
+	assert.Nil(s.T(), l.wait(context.Background()))
+
+	start := time.Now()
+	assert.Nil(s.T(), l.wait(context.Background()))
+	assert.True(s.T(), time.Since(start) >= 30*time.Millisecond)
+
+	reports, err := GetRateLimitReports(context.Background())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(reports))
+	assert.Equal(s.T(), "RateLimitWaitCommand", reports[0].Endpoint)
+	assert.Equal(s.T(), int64(1), reports[0].Throttled)
+	assert.Equal(s.T(), int64(0), reports[0].Rejected)
+	assert.True(s.T(), reports[0].WaitTime >= 30*time.Millisecond)
+}
+
+func (s *RateLimiterTestSuite) TestWait_ReturnsError_WhenModeIsReject() {
+	l := buildRateLimiter("RateLimitRejectCommand", constants.RateLimitModeReject, 1, time.Second)
+
+	assert.Nil(s.T(), l.wait(context.Background()))
+	assert.Equal(s.T(), mErr.NewRateLimitedError("RateLimitRejectCommand"), l.wait(context.Background()))
+
+	reports, _ := GetRateLimitReports(context.Background())
+	assert.Equal(s.T(), 1, len(reports))
+	assert.Equal(s.T(), int64(0), reports[0].Throttled)
+	assert.Equal(s.T(), int64(1), reports[0].Rejected)
+}
+
+func (s *RateLimiterTestSuite) TestWait_ReturnsError_WhenWaitExceedsMaxWait() {
+	l := buildRateLimiter("RateLimitMaxWaitCommand", constants.RateLimitModeWait, 1, 10*time.Millisecond)
+
+	assert.Nil(s.T(), l.wait(context.Background()))
+
+	start := time.Now()
+	assert.Equal(s.T(), mErr.NewRateLimitedError("RateLimitMaxWaitCommand"), l.wait(context.Background()))
+	assert.True(s.T(), time.Since(start) < 10*time.Millisecond)
+}
+
+func (s *RateLimiterTestSuite) TestWait_ReturnsContextError_WhenContextIsDone() {
+	l := buildRateLimiter("RateLimitContextCommand", constants.RateLimitModeWait, 1, time.Second)
+	assert.Nil(s.T(), l.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(s.T(), context.DeadlineExceeded, l.wait(ctx))
+}
+
+func (s *RateLimiterTestSuite) TestGetBody_SkipsOrigin_WhenRateLimited() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString("foo")
+
+	oc := buildOriginClient("RateLimitOriginCommand", 3)
+	oc.limiter = buildRateLimiter("RateLimitOriginCommand", constants.RateLimitModeReject, 1, time.Second)
+
+	body, err := oc.getBody(context.Background(), "http://foo.com/bar")
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "foo", string(body))
+
+	body, err = oc.getBody(context.Background(), "http://foo.com/bar")
+	assert.Nil(s.T(), body)
+	assert.Equal(s.T(), mErr.NewRateLimitedError("RateLimitOriginCommand"), err)
+}
+
+func (s *RateLimiterTestSuite) TestNewRateLimiter_ReturnsNil_WhenDisabled() {
+	assert.Nil(s.T(), newRateLimiter(constants.MangacanSource, constants.GetMangaListCommand))
+}
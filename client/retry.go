@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/afex/hystrix-go/hystrix"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+type retrier struct {
+	command string
+	config  config.RetryConfig
+}
+
+type retryableError struct {
+	error
+}
+
+// do runs fetch and retries timeouts, 5xx responses and empty or null bodies
+// with an exponential backoff. It stops early once the hystrix circuit of the
+// command is open or ctx is done.
+func (r *retrier) do(ctx context.Context, fetch func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fetch()
+		if err == nil {
+			return nil
+		}
+
+		var re retryableError
+		if !errors.As(err, &re) || attempt >= r.config.MaxAttempts || r.isCircuitOpen() || ctx.Err() != nil {
+			break
+		}
+
+		backoff := r.getBackoff(attempt)
+		logger.Warnf("Retrying %s in %s after attempt %d failed: %s", r.command, backoff, attempt, err.Error())
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	var re retryableError
+	if errors.As(err, &re) {
+		return re.error
+	}
+	return err
+}
+
+func (r *retrier) isCircuitOpen() bool {
+	cb, _, err := hystrix.GetCircuit(r.command)
+	return err == nil && cb.IsOpen()
+}
+
+func (r *retrier) getBackoff(attempt int) time.Duration {
+	backoff := float64(r.config.InitialBackoff) * math.Pow(r.config.BackoffMultiplier, float64(attempt-1))
+	backoff = math.Min(backoff, float64(r.config.MaxBackoff))
+
+	if r.config.MaxJitter > 0 {
+		backoff += float64(rand.Int63n(int64(r.config.MaxJitter)))
+	}
+	return time.Duration(backoff)
+}
+
+func isRetryableHystrixError(err error) bool {
+	switch err {
+	case hystrix.ErrCircuitOpen, hystrix.ErrMaxConcurrency:
+		return false
+	}
+	return true
+}
+
+func newRetrier(source, command string) *retrier {
+	return &retrier{
+		command: getSourceCommand(source, command),
+		config:  config.RetryPolicy(command),
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/afex/hystrix-go/hystrix"
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+}
+
+func (s *RetryTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
+
+func buildRetrier(command string, maxAttempts int) *retrier {
+	return &retrier{
+		command: command,
+		config: config.RetryConfig{
+			MaxAttempts:       maxAttempts,
+			InitialBackoff:    time.Millisecond,
+			MaxBackoff:        5 * time.Millisecond,
+			BackoffMultiplier: 2,
+		},
+	}
+}
+
+func buildOriginClient(command string, maxAttempts int) *originClient {
+	return &originClient{
+		command:         command,
+		httpClient:      newHystrixClient(command, time.Second, config.HystrixConfig()),
+		retrier:         buildRetrier(command, maxAttempts),
+		headers:         http.Header{},
+		maxResponseSize: 1024,
+	}
+}
+
+func (s *RetryTestSuite) TestFetch_ReturnsBody_WhenRetrySucceeds() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusBadGateway)
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString(constants.NullText)
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString(`{"foo":"bar"}`)
+
+	oc := buildOriginClient("RetrySucceedsCommand", 3)
+	body, err := oc.getBody(context.Background(), "http://foo.com/bar")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), `{"foo":"bar"}`, string(body))
+	assert.True(s.T(), gock.IsDone())
+}
+
+func (s *RetryTestSuite) TestFetch_ReturnsLastError_WhenAttemptsAreExhausted() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Times(2).Reply(http.StatusOK).BodyString(constants.NullText)
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString(`{"foo":"bar"}`)
+
+	oc := buildOriginClient("RetryExhaustedCommand", 2)
+	body, err := oc.getBody(context.Background(), "http://foo.com/bar")
+
+	assert.Nil(s.T(), body)
+	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
+	assert.False(s.T(), gock.IsDone())
+}
+
+func (s *RetryTestSuite) TestIsRetryableHystrixError_ReturnsFalse_WhenCircuitRejectsCall() {
+	assert.False(s.T(), isRetryableHystrixError(hystrix.ErrCircuitOpen))
+	assert.False(s.T(), isRetryableHystrixError(hystrix.ErrMaxConcurrency))
+	assert.True(s.T(), isRetryableHystrixError(hystrix.ErrTimeout))
+}
+
+func (s *RetryTestSuite) TestIsCircuitOpen_ReturnsFalse_WhenCircuitIsHealthy() {
+	r := buildRetrier("RetryHealthyCommand", 3)
+
+	assert.False(s.T(), r.isCircuitOpen())
+}
+
+func (s *RetryTestSuite) TestGetBackoff_GrowsExponentiallyUpToMax() {
+	r := buildRetrier("foo", 5)
+
+	assert.Equal(s.T(), time.Millisecond, r.getBackoff(1))
+	assert.Equal(s.T(), 2*time.Millisecond, r.getBackoff(2))
+	assert.Equal(s.T(), 4*time.Millisecond, r.getBackoff(3))
+	assert.Equal(s.T(), 5*time.Millisecond, r.getBackoff(4))
+}
+
+func (s *RetryTestSuite) TestFetch_ReturnsError_WhenContextIsCancelled() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Reply(http.StatusOK).BodyString(`{"foo":"bar"}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	oc := buildOriginClient("RetryCancelledCommand", 3)
+	body, err := oc.getBody(ctx, "http://foo.com/bar")
+
+	assert.Nil(s.T(), body)
+	assert.Equal(s.T(), context.Canceled, err)
+	assert.False(s.T(), gock.IsDone())
+}
+
+func (s *RetryTestSuite) TestFetch_StopsRetrying_WhenDeadlineExceeds() {
+	defer gock.Off()
+	gock.New("http://foo.com").Get("/bar").Persist().Reply(http.StatusBadGateway)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	oc := buildOriginClient("RetryDeadlineCommand", 100)
+	oc.retrier.config.InitialBackoff = 50 * time.Millisecond
+	oc.retrier.config.MaxBackoff = 50 * time.Millisecond
+	body, err := oc.getBody(ctx, "http://foo.com/bar")
+
+	assert.Nil(s.T(), body)
+	assert.Equal(s.T(), context.DeadlineExceeded, err)
+}
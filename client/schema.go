@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/go-redis/redis"
+)
+
+// schemaReportsKey holds the latest report of every endpoint in Redis, so
+// reports from the API and worker processes are read together.
+const schemaReportsKey = "SchemaReports"
+
+// SchemaReport describes how the latest origin response of an endpoint
+// differs from the domain struct it is decoded into.
+type SchemaReport struct {
+	Endpoint         string
+	UnknownFields    map[string]int
+	MissingFields    map[string]int
+	MismatchedFields map[string]string
+	CheckedAt        time.Time
+	DriftDetectedAt  time.Time
+}
+
+func (r SchemaReport) HasDrift() bool {
+	return len(r.UnknownFields) > 0 || len(r.MissingFields) > 0 || len(r.MismatchedFields) > 0
+}
+
+func (r SchemaReport) signature() string {
+	keys := []string{}
+	for k := range r.UnknownFields {
+		keys = append(keys, "+"+k)
+	}
+	for k := range r.MissingFields {
+		keys = append(keys, "-"+k)
+	}
+	for k, v := range r.MismatchedFields {
+		keys = append(keys, "~"+k+":"+v)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+func GetSchemaReports(ctx context.Context) ([]SchemaReport, error) {
+	fields, err := appcontext.GetRedisClient().WithContext(ctx).HGetAll(schemaReportsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	reports := []SchemaReport{}
+	for _, value := range fields {
+		var r SchemaReport
+		if err := json.Unmarshal([]byte(value), &r); err != nil {
+			continue
+		}
+		reports = append(reports, r)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Endpoint < reports[j].Endpoint
+	})
+	return reports, nil
+}
+
+func getSchemaReport(ctx context.Context, redisClient *redis.Client, endpoint string) (SchemaReport, bool) {
+	value, err := redisClient.WithContext(ctx).HGet(schemaReportsKey, endpoint).Result()
+	if err != nil {
+		if err != redis.Nil {
+			logger.Errorf("Failed to get schema report of %s - %s", endpoint, err)
+		}
+		return SchemaReport{}, false
+	}
+
+	var r SchemaReport
+	if err := json.Unmarshal([]byte(value), &r); err != nil {
+		return SchemaReport{}, false
+	}
+	return r, true
+}
+
+// checkSchema decodes body loosely and compares it against the JSON shape of
+// target, then stores the result as the latest report for endpoint. It never
+// affects the response handed back to callers.
+func checkSchema(ctx context.Context, endpoint string, body []byte, target interface{}) {
+	var raw interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return
+	}
+
+	report := SchemaReport{
+		Endpoint:         endpoint,
+		UnknownFields:    map[string]int{},
+		MissingFields:    map[string]int{},
+		MismatchedFields: map[string]string{},
+		CheckedAt:        time.Now().UTC(),
+	}
+	inspectSchema("", reflect.TypeOf(target), raw, &report)
+
+	redisClient := appcontext.GetRedisClient()
+	prev, ok := getSchemaReport(ctx, redisClient, endpoint)
+	if report.HasDrift() {
+		report.DriftDetectedAt = report.CheckedAt
+		if ok && prev.HasDrift() {
+			report.DriftDetectedAt = prev.DriftDetectedAt
+		}
+		if !ok || prev.signature() != report.signature() {
+			logger.Warnf("Origin schema drift detected on %s, unknown: %v, missing: %v, mismatched: %v",
+				endpoint, report.UnknownFields, report.MissingFields, report.MismatchedFields)
+		}
+	}
+
+	value, _ := json.Marshal(report)
+	err := redisClient.WithContext(ctx).HSet(schemaReportsKey, endpoint, value).Err()
+	if err != nil {
+		logger.Errorf("Failed to set schema report of %s - %s", endpoint, err)
+	}
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func inspectSchema(path string, t reflect.Type, value interface{}, report *SchemaReport) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types with their own decoding, like domain.ChapterID, accept several
+	// JSON shapes, so they are not checked here.
+	if value == nil || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			report.MismatchedFields[path] = getTypeMismatch("object", value)
+			return
+		}
+
+		known := map[string]bool{}
+		for i := 0; i < t.NumField(); i++ {
+			name := getJSONFieldName(t.Field(i))
+			if name == "" {
+				continue
+			}
+			known[name] = true
+
+			fv, ok := obj[name]
+			if !ok {
+				report.MissingFields[joinSchemaPath(path, name)]++
+				continue
+			}
+			inspectSchema(joinSchemaPath(path, name), t.Field(i).Type, fv, report)
+		}
+
+		for name := range obj {
+			if !known[name] {
+				report.UnknownFields[joinSchemaPath(path, name)]++
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := value.([]interface{})
+		if !ok {
+			report.MismatchedFields[path] = getTypeMismatch("array", value)
+			return
+		}
+		for _, item := range arr {
+			inspectSchema(path+"[]", t.Elem(), item, report)
+		}
+	case reflect.String:
+		if _, ok := value.(string); !ok {
+			report.MismatchedFields[path] = getTypeMismatch("string", value)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, ok := value.(float64); !ok {
+			report.MismatchedFields[path] = getTypeMismatch("number", value)
+		}
+	case reflect.Bool:
+		if _, ok := value.(bool); !ok {
+			report.MismatchedFields[path] = getTypeMismatch("boolean", value)
+		}
+	}
+}
+
+func getJSONFieldName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name
+	}
+	return f.Name
+}
+
+func getTypeMismatch(expected string, value interface{}) string {
+	actual := "unknown"
+	switch value.(type) {
+	case map[string]interface{}:
+		actual = "object"
+	case []interface{}:
+		actual = "array"
+	case string:
+		actual = "string"
+	case float64:
+		actual = "number"
+	case bool:
+		actual = "boolean"
+	}
+	return fmt.Sprintf("expected %s, got %s", expected, actual)
+}
+
+func joinSchemaPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchemaTestSuite struct {
+	suite.Suite
+}
+
+func (s *SchemaTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *SchemaTestSuite) SetupTest() {
+	appcontext.GetRedisClient().Del(schemaReportsKey)
+}
+
+func (s *SchemaTestSuite) TearDownTest() {
+	appcontext.GetRedisClient().Del(schemaReportsKey)
+}
+
+func getSchemaReports() []SchemaReport {
+	reports, _ := GetSchemaReports(context.Background())
+	return reports
+}
+
+func TestSchemaTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) TestCheckSchema_RecordsNoDrift_WhenResponseMatches() {
+	body := `{"chapter":[{"url":"http://foo.com/1.jpg","page":1}]}`
+
+	checkSchema(context.Background(), "foo", []byte(body), domain.ContentListResponse{})
+
+	reports := getSchemaReports()
+	assert.Equal(s.T(), 1, len(reports))
+	assert.Equal(s.T(), "foo", reports[0].Endpoint)
+	assert.False(s.T(), reports[0].HasDrift())
+	assert.True(s.T(), reports[0].DriftDetectedAt.IsZero())
+}
+
+func (s *SchemaTestSuite) TestCheckSchema_RecordsDrift_WhenResponseDiffers() {
+	body := `{"chapter":[{"url":"http://foo.com/1.jpg","page":"1","read":0},{"page":2,"read":1}],"hidden_key":"x"}`
+
+	checkSchema(context.Background(), "foo", []byte(body), domain.ContentListResponse{})
+
+	reports := getSchemaReports()
+	assert.Equal(s.T(), 1, len(reports))
+	assert.True(s.T(), reports[0].HasDrift())
+	assert.Equal(s.T(), map[string]int{"chapter[].read": 2, "hidden_key": 1}, reports[0].UnknownFields)
+	assert.Equal(s.T(), map[string]int{"chapter[].url": 1}, reports[0].MissingFields)
+	assert.Equal(s.T(), map[string]string{"chapter[].page": "expected number, got string"}, reports[0].MismatchedFields)
+	assert.False(s.T(), reports[0].DriftDetectedAt.IsZero())
+}
+
+func (s *SchemaTestSuite) TestCheckSchema_KeepsFirstDriftTime_WhenDriftPersists() {
+	body := `{"chapter":[],"jenis":"manga"}`
+
+	checkSchema(context.Background(), "foo", []byte(body), domain.ContentListResponse{})
+	first := getSchemaReports()[0].DriftDetectedAt
+	checkSchema(context.Background(), "foo", []byte(body), domain.ContentListResponse{})
+
+	assert.Equal(s.T(), first, getSchemaReports()[0].DriftDetectedAt)
+}
+
+func (s *SchemaTestSuite) TestCheckSchema_IgnoresInvalidJSON() {
+	checkSchema(context.Background(), "foo", []byte("foo"), domain.ContentListResponse{})
+
+	assert.Empty(s.T(), getSchemaReports())
+}
+
+func (s *SchemaTestSuite) TestGetSchemaReports_ReturnsReportsOfEveryEndpoint() {
+	body := `{"chapter":[]}`
+
+	checkSchema(context.Background(), "foo", []byte(body), domain.ContentListResponse{})
+	checkSchema(context.Background(), "bar", []byte(body), domain.ContentListResponse{})
+
+	reports, err := GetSchemaReports(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(reports))
+	assert.Equal(s.T(), "bar", reports[0].Endpoint)
+	assert.Equal(s.T(), "foo", reports[1].Endpoint)
+}
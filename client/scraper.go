@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+const (
+	chapterLinkClass  = "chaptersrec"
+	contentImageClass = "picture"
+)
+
+var (
+	htmlRowRegex    = regexp.MustCompile(`(?is)<tr\b[^>]*>(.*?)</tr>`)
+	htmlAnchorRegex = regexp.MustCompile(`(?is)<a\b([^>]*)>(.*?)</a>`)
+	htmlImageRegex  = regexp.MustCompile(`(?is)<img\b([^>]*)>`)
+	htmlAttrRegex   = regexp.MustCompile(`(?is)([a-z][a-z0-9_:-]*)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	htmlTagRegex    = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlDateRegex   = regexp.MustCompile(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`)
+)
+
+// htmlScraper reads chapter lists and page images from the public origin
+// website. It backs the JSON clients when their endpoints return nothing.
+type htmlScraper struct {
+	origin *originClient
+}
+
+type chapterLink struct {
+	chapter domain.Chapter
+	url     string
+}
+
+func buildChapterPageEndpoint(titleID string) string {
+	return config.BaseURL() + "/baca-komik-" + titleID + "-bahasa-indonesia-online-terbaru.html"
+}
+
+func (c *htmlScraper) GetChapterList(ctx context.Context, titleID string) (*domain.ChapterListResponse, error) {
+	links, err := c.getChapterLinks(ctx, titleID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &domain.ChapterListResponse{}
+	for _, l := range links {
+		response.Chapters = append(response.Chapters, l.chapter)
+	}
+	return response, nil
+}
+
+func (c *htmlScraper) GetContentList(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error) {
+	links, err := c.getChapterLinks(ctx, titleID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range links {
+		if l.chapter.Number != chapter {
+			continue
+		}
+
+		body, err := c.origin.getBody(ctx, l.url)
+		if err != nil {
+			return nil, err
+		}
+
+		response := parseContentListHTML(l.url, body)
+		if len(response.Contents) == 0 {
+			logger.Errorf("Could not find any page image on %s", l.url)
+			return nil, mErr.NewInvalidOriginResponseError()
+		}
+		return response, nil
+	}
+	return nil, mErr.NewNotFoundError("chapter")
+}
+
+func (c *htmlScraper) getChapterLinks(ctx context.Context, titleID string) ([]chapterLink, error) {
+	pageURL := buildChapterPageEndpoint(titleID)
+	body, err := c.origin.getBody(ctx, pageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	links := parseChapterListHTML(pageURL, titleID, body)
+	if len(links) == 0 {
+		logger.Errorf("Could not find any chapter link on %s", pageURL)
+		return nil, mErr.NewInvalidOriginResponseError()
+	}
+	return links, nil
+}
+
+// parseChapterListHTML reads the chapter links of a title page. The chapter
+// number comes from the link target since titles may contain other numbers.
+func parseChapterListHTML(pageURL, titleID string, body []byte) []chapterLink {
+	numberRegex := regexp.MustCompile(`baca-komik-` + regexp.QuoteMeta(titleID) + `-(\d+(?:\.\d+)?[a-z]*)-`)
+
+	links := []chapterLink{}
+	for _, row := range htmlRowRegex.FindAllStringSubmatch(string(body), -1) {
+		for _, a := range htmlAnchorRegex.FindAllStringSubmatch(row[1], -1) {
+			attrs := parseHTMLAttrs(a[1])
+			if !hasHTMLClass(attrs, chapterLinkClass) {
+				continue
+			}
+
+			m := numberRegex.FindStringSubmatch(attrs["href"])
+			if m == nil {
+				continue
+			}
+			number, err := domain.ParseChapterID(m[1])
+			if err != nil {
+				continue
+			}
+
+			links = append(links, chapterLink{
+				chapter: domain.Chapter{
+					Number:       number,
+					Title:        getHTMLText(a[2]),
+					TitleID:      titleID,
+					ModifiedDate: htmlDateRegex.FindString(getHTMLText(row[1])),
+				},
+				url: resolveHTMLURL(pageURL, attrs["href"]),
+			})
+			break
+		}
+	}
+	return links
+}
+
+// parseContentListHTML reads the page images of a chapter page in document
+// order. Images without the reader class, like logos and banners, are skipped.
+func parseContentListHTML(pageURL string, body []byte) *domain.ContentListResponse {
+	response := &domain.ContentListResponse{}
+	for _, img := range htmlImageRegex.FindAllStringSubmatch(string(body), -1) {
+		attrs := parseHTMLAttrs(img[1])
+		if !hasHTMLClass(attrs, contentImageClass) || attrs["src"] == "" {
+			continue
+		}
+
+		response.Contents = append(response.Contents, domain.Content{
+			ImageURL: resolveHTMLURL(pageURL, attrs["src"]),
+			Page:     len(response.Contents) + 1,
+		})
+	}
+	return response
+}
+
+func parseHTMLAttrs(s string) map[string]string {
+	attrs := map[string]string{}
+	for _, m := range htmlAttrRegex.FindAllStringSubmatch(s, -1) {
+		attrs[strings.ToLower(m[1])] = html.UnescapeString(m[2] + m[3])
+	}
+	return attrs
+}
+
+func hasHTMLClass(attrs map[string]string, class string) bool {
+	for _, c := range strings.Fields(attrs["class"]) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func getHTMLText(s string) string {
+	text := html.UnescapeString(htmlTagRegex.ReplaceAllString(s, ""))
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func resolveHTMLURL(pageURL, ref string) string {
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return ref
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+func NewHTMLScraper() *htmlScraper {
+	return &htmlScraper{
+		origin: newOriginClient(constants.MangacanSource, constants.GetHTMLPageCommand),
+	}
+}
@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HTMLScraperTestSuite struct {
+	suite.Suite
+}
+
+func (s *HTMLScraperTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestHTMLScraperTestSuite(t *testing.T) {
+	suite.Run(t, new(HTMLScraperTestSuite))
+}
+
+func readHTMLFixture(s *HTMLScraperTestSuite, name string) []byte {
+	body, err := ioutil.ReadFile("fixtures/html/" + name)
+	s.Require().NoError(err)
+	return body
+}
+
+func (s *HTMLScraperTestSuite) TestParseChapterListHTML() {
+	links := parseChapterListHTML("http://mangacanblog.com/baca-komik-bleach-bahasa-indonesia-online-terbaru.html",
+		titleID, readHTMLFixture(s, "chapter_list.html"))
+
+	assert.Equal(s.T(), []chapterLink{
+		{
+			chapter: domain.Chapter{Number: "686", Title: "Bleach 686 - Death & Strawberry (tamat)", TitleID: titleID, ModifiedDate: "2016-08-18 18:59:58"},
+			url:     "http://mangacanblog.com/baca-komik-bleach-686-687-bahasa-indonesia-bleach-686-terbaru.html",
+		},
+		{
+			chapter: domain.Chapter{Number: "685.5", Title: "Bleach 685.5 - Extra", TitleID: titleID, ModifiedDate: "2016-08-10 10:00:00"},
+			url:     "http://mangacanblog.com/baca-komik-bleach-685.5-686-bahasa-indonesia-bleach-685.5-terbaru.html",
+		},
+		{
+			chapter: domain.Chapter{Number: "685", Title: "Bleach 685 - A Perfect End", TitleID: titleID, ModifiedDate: "2016-08-04 21:22:52"},
+			url:     "http://mangacanblog.com/baca-komik-bleach-685-686-bahasa-indonesia-bleach-685-terbaru.html",
+		},
+	}, links)
+}
+
+func (s *HTMLScraperTestSuite) TestParseChapterListHTML_ReturnsEmpty_WhenTitleDoesNotMatch() {
+	links := parseChapterListHTML("http://mangacanblog.com/", "one_piece", readHTMLFixture(s, "chapter_list.html"))
+
+	assert.Empty(s.T(), links)
+}
+
+func (s *HTMLScraperTestSuite) TestParseContentListHTML() {
+	res := parseContentListHTML("http://mangacanblog.com/baca-komik-bleach-686-687-bahasa-indonesia-bleach-686-terbaru.html",
+		readHTMLFixture(s, "content_list.html"))
+
+	assert.Equal(s.T(), []domain.Content{
+		{ImageURL: "http://mangacanblog.com/mangas/bleach/686/mangacanblogcom_bleach_686_01.jpg", Page: 1},
+		{ImageURL: "http://mangacanblog.com/mangas/bleach/686/mangacanblogcom_bleach_686_02.jpg", Page: 2},
+		{ImageURL: "http://mangacanblog.com/mangas/bleach/686/mangacanblogcom_bleach_686_03.jpg", Page: 3},
+	}, res.Contents)
+}
+
+func (s *HTMLScraperTestSuite) TestGetContentList_ScrapesChapterPage() {
+	defer gock.Off()
+	gock.New(buildChapterPageEndpoint(titleID)).
+		Reply(http.StatusOK).
+		BodyString(string(readHTMLFixture(s, "chapter_list.html")))
+	gock.New("http://mangacanblog.com/baca-komik-bleach-685.5-686-bahasa-indonesia-bleach-685.5-terbaru.html").
+		Reply(http.StatusOK).
+		BodyString(string(readHTMLFixture(s, "content_list.html")))
+
+	res, err := NewHTMLScraper().GetContentList(context.Background(), titleID, "685.5")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(res.Contents))
+	assert.True(s.T(), gock.IsDone())
+}
+
+func (s *HTMLScraperTestSuite) TestGetContentList_ReturnsNotFound_WhenChapterIsNotListed() {
+	defer gock.Off()
+	gock.New(buildChapterPageEndpoint(titleID)).
+		Reply(http.StatusOK).
+		BodyString(string(readHTMLFixture(s, "chapter_list.html")))
+
+	res, err := NewHTMLScraper().GetContentList(context.Background(), titleID, "700")
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), mErr.NewNotFoundError("chapter"), err)
+}
+
+func (s *HTMLScraperTestSuite) TestGetChapterList_ReturnsError_WhenPageHasNoChapters() {
+	defer gock.Off()
+	gock.New(buildChapterPageEndpoint(titleID)).
+		Reply(http.StatusOK).
+		BodyString("<html><body>maintenance</body></html>")
+
+	res, err := NewHTMLScraper().GetChapterList(context.Background(), titleID)
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), constants.InvalidJSONResponseError, err.Error())
+}
+
+func (s *HTMLScraperTestSuite) TestChapterClient_FallsBackToScraper_WhenOriginReturnsNull() {
+	defer gock.Off()
+	gock.New(buildChapterListEndpoint(titleID)).
+		Persist().
+		Reply(http.StatusOK).
+		BodyString(constants.NullText)
+	gock.New(buildChapterPageEndpoint(titleID)).
+		Reply(http.StatusOK).
+		BodyString(string(readHTMLFixture(s, "chapter_list.html")))
+
+	cc := NewChapterClient()
+	cc.fallback = NewHTMLScraper()
+	res, err := cc.GetChapterList(context.Background(), titleID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, len(res.Chapters))
+	assert.Equal(s.T(), domain.ChapterID("686"), res.Chapters[0].Number)
+}
+
+func (s *HTMLScraperTestSuite) TestNewChapterClient_HasNoFallback_WhenDisabled() {
+	assert.Nil(s.T(), NewChapterClient().fallback)
+	assert.Nil(s.T(), NewContentClient().fallback)
+}
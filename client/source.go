@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Source groups the clients of a single origin provider.
+type Source interface {
+	MangaClient
+	ChapterClient
+	ContentClient
+	Name() string
+}
+
+type SourceFactory func() Source
+
+var (
+	sourceMu       sync.RWMutex
+	sourceRegistry = map[string]SourceFactory{}
+)
+
+func RegisterSource(name string, factory SourceFactory) {
+	sourceMu.Lock()
+	defer sourceMu.Unlock()
+	sourceRegistry[name] = factory
+}
+
+func NewSource(name string) (Source, error) {
+	sourceMu.RLock()
+	factory, ok := sourceRegistry[name]
+	sourceMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown origin source: %s", name)
+	}
+	return factory(), nil
+}
+
+func getSourceCommand(source, command string) string {
+	return source + "." + command
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SourceTestSuite struct {
+	suite.Suite
+}
+
+func (s *SourceTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func TestSourceTestSuite(t *testing.T) {
+	suite.Run(t, new(SourceTestSuite))
+}
+
+func (s *SourceTestSuite) TestNewSource_ReturnsError_WhenSourceIsUnknown() {
+	src, err := NewSource("foo")
+
+	assert.Nil(s.T(), src)
+	assert.Equal(s.T(), "unknown origin source: foo", err.Error())
+}
+
+func (s *SourceTestSuite) TestNewSource_ReturnsMangacanSource() {
+	src, err := NewSource(constants.MangacanSource)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), constants.MangacanSource, src.Name())
+}
+
+func (s *SourceTestSuite) TestNewSource_ReturnsRegisteredSource() {
+	RegisterSource("bar", func() Source { return NewMangacanSource() })
+
+	src, err := NewSource("bar")
+
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), src)
+}
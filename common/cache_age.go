@@ -0,0 +1,48 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type cacheAgeKey struct{}
+
+type cacheAge struct {
+	mu    sync.Mutex
+	age   time.Duration
+	stale bool
+}
+
+// WithCacheAge returns a context that collects the age of the cached values
+// used to build a response, so handlers can tell clients about stale data.
+func WithCacheAge(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheAgeKey{}, &cacheAge{})
+}
+
+// RecordCacheAge keeps the oldest age recorded on ctx. It does nothing when
+// ctx was not made by WithCacheAge.
+func RecordCacheAge(ctx context.Context, age time.Duration, stale bool) {
+	ca, ok := ctx.Value(cacheAgeKey{}).(*cacheAge)
+	if !ok {
+		return
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	if age > ca.age {
+		ca.age = age
+	}
+	ca.stale = ca.stale || stale
+}
+
+func GetCacheAge(ctx context.Context) (age time.Duration, stale bool) {
+	ca, ok := ctx.Value(cacheAgeKey{}).(*cacheAge)
+	if !ok {
+		return 0, false
+	}
+
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	return ca.age, ca.stale
+}
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CacheAgeTestSuite struct {
+	suite.Suite
+}
+
+func TestCacheAgeTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheAgeTestSuite))
+}
+
+func (s *CacheAgeTestSuite) TestRecordCacheAge_KeepsOldestAgeAndStaleness() {
+	ctx := WithCacheAge(context.Background())
+
+	RecordCacheAge(ctx, 2*time.Minute, true)
+	RecordCacheAge(ctx, time.Minute, false)
+
+	age, stale := GetCacheAge(ctx)
+	assert.Equal(s.T(), 2*time.Minute, age)
+	assert.True(s.T(), stale)
+}
+
+func (s *CacheAgeTestSuite) TestRecordCacheAge_DoesNothing_WhenContextHasNoCacheAge() {
+	RecordCacheAge(context.Background(), time.Minute, true)
+
+	age, stale := GetCacheAge(context.Background())
+	assert.Equal(s.T(), time.Duration(0), age)
+	assert.False(s.T(), stale)
+}
@@ -1,11 +1,45 @@
 package common
 
 import (
-	"fmt"
 	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
 )
 
-func GetFormattedChapterNumber(chapter float32) string {
-	s := fmt.Sprintf("%.4f", chapter)
-	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+var originLocation = loadOriginLocation()
+
+func loadOriginLocation() *time.Location {
+	loc, err := time.LoadLocation(constants.OriginTimeLocation)
+	if err != nil {
+		return time.FixedZone("WIB", 7*60*60)
+	}
+	return loc
+}
+
+func ParseGenres(genre string) []string {
+	seen := map[string]bool{}
+	genres := []string{}
+	for _, g := range strings.Split(genre, ",") {
+		name := GetCanonicalGenre(g)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		genres = append(genres, name)
+	}
+	return genres
+}
+
+func GetCanonicalGenre(genre string) string {
+	words := strings.Fields(strings.ToLower(genre))
+	return strings.Title(strings.Join(words, " "))
+}
+
+func ParseOriginTime(value string) (time.Time, error) {
+	t, err := time.ParseInLocation(constants.OriginTimeLayout, strings.TrimSpace(value), originLocation)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
 }
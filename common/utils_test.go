@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -15,10 +16,25 @@ func TestUtilsSuiteSuite(t *testing.T) {
 	suite.Run(t, new(UtilsTestSuite))
 }
 
-func (s *UtilsTestSuite) TestGetFormattedChapterNumber_WhenChapterHasZeroAfterComma() {
-	assert.Equal(s.T(), "100", GetFormattedChapterNumber(float32(100.0)))
+func (s *UtilsTestSuite) TestParseGenres_ReturnsEmptyList_WhenGenreIsBlank() {
+	assert.Equal(s.T(), []string{}, ParseGenres(" , "))
 }
 
-func (s *UtilsTestSuite) TestGetFormattedChapterNumber_WhenChapterHasNoZeroAfterComma() {
-	assert.Equal(s.T(), "100.1", GetFormattedChapterNumber(float32(100.1)))
+func (s *UtilsTestSuite) TestParseGenres_ReturnsCanonicalUniqueGenres() {
+	genres := ParseGenres("Action,  adventure, School   Life, sci-fi, ACTION,")
+
+	assert.Equal(s.T(), []string{"Action", "Adventure", "School Life", "Sci-Fi"}, genres)
+}
+
+func (s *UtilsTestSuite) TestParseOriginTime_ReturnsError_WhenValueIsInvalid() {
+	_, err := ParseOriginTime("0000-00-00 00:00:00")
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *UtilsTestSuite) TestParseOriginTime_ReturnsUTCTime_WhenValueIsInWIB() {
+	t, err := ParseOriginTime("2019-04-12 13:05:59")
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "2019-04-12T06:05:59Z", t.Format(time.RFC3339))
 }
@@ -1,28 +1,156 @@
 package config
 
 import (
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/gojektech/heimdall"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	port               int
+	requestTimeout     time.Duration
 	logLevel           string
 	redisHost          string
 	redisPort          int
 	redisPool          int
 	workerRedisAddress string
 	baseURL            string
+	originSource       string
+	originConfig       OriginConfig
 	popularMangaTags   []string
 	adsContentTags     []string
 	hystrixConfig      heimdall.HystrixCommandConfig
+	compressionConfig  CompressionConfig
+	imageProxyConfig   ImageProxyConfig
+	localCacheConfig   LocalCacheConfig
+	cacheTTLConfig     CacheTTLConfig
+	cacheCodecConfig   CacheCodecConfig
+	retryConfigs       map[string]RetryConfig
+	rateLimitConfigs   map[string]RateLimitConfig
+	htmlFallbacks      map[string]bool
+}
+
+type OriginConfig struct {
+	UserAgent       string
+	ExtraHeaders    map[string]string
+	MaxResponseSize int64
+}
+
+type RetryConfig struct {
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+	MaxJitter         time.Duration
+}
+
+// RateLimitConfig is the token bucket budget of an origin command, shared by
+// every API and worker process. Calls over budget wait up to MaxWait for a
+// token in RateLimitModeWait, or fail right away in RateLimitModeReject.
+type RateLimitConfig struct {
+	Enabled           bool
+	RequestsPerSecond float64
+	Burst             int
+	Mode              string
+	MaxWait           time.Duration
+}
+
+type ImageProxyConfig struct {
+	RewriteURLs  bool
+	BaseURL      string
+	AllowedHosts []string
+	CacheDir     string
+	CacheTTL     time.Duration
+	CacheMaxSize int64
+}
+
+// CacheTTL is how long a cached value is fresh, and how long it is kept in
+// total so it can be served stale while it is refreshed.
+type CacheTTL struct {
+	Expiration     time.Duration
+	HardExpiration time.Duration
+}
+
+// CacheTTLConfig holds the cache lifetimes. Chapter lists of completed and
+// popular titles have their own, and TitleChapters overrides single titles.
+type CacheTTLConfig struct {
+	Manga            CacheTTL
+	Chapter          CacheTTL
+	Content          CacheTTL
+	CompletedChapter CacheTTL
+	PopularChapter   CacheTTL
+	TitleChapters    map[string]CacheTTL
+}
+
+// CacheCodecConfig is how cache values are written to Redis. Values written
+// with any other setting can still be read, so it can be changed anytime
+// once every process runs a version that knows it.
+type CacheCodecConfig struct {
+	Format      string
+	Compression string
+}
+
+// LocalCacheConfig bounds the in-process cache kept by each cache manager in
+// front of Redis. MaxAge caps how long a value may miss an invalidation.
+type LocalCacheConfig struct {
+	MaxEntries int
+	MaxAge     time.Duration
+}
+
+type CompressionConfig struct {
+	MinSize     int
+	GzipLevel   int
+	BrotliLevel int
 }
 
 var appConfig *Config
 
 func Load() {
 	viper.SetDefault("APP_PORT", "3000")
+	viper.SetDefault("REQUEST_TIMEOUT_MS", "30000")
 	viper.SetDefault("LOG_LEVEL", "debug")
+	viper.SetDefault("ORIGIN_SOURCE", "mangacan")
+	viper.SetDefault("ORIGIN_USER_AGENT", "mangindo-feeder")
+	viper.SetDefault("ORIGIN_MAX_RESPONSE_BYTES", "10485760")
+	viper.SetDefault("GET_CHAPTER_LIST_HTML_FALLBACK_ENABLED", "false")
+	viper.SetDefault("GET_CONTENT_LIST_HTML_FALLBACK_ENABLED", "false")
+	viper.SetDefault("RETRY_MAX_ATTEMPTS", "3")
+	viper.SetDefault("RETRY_INITIAL_BACKOFF_MS", "100")
+	viper.SetDefault("RETRY_MAX_BACKOFF_MS", "2000")
+	viper.SetDefault("RETRY_BACKOFF_MULTIPLIER", "2")
+	viper.SetDefault("RETRY_MAX_JITTER_MS", "50")
+	viper.SetDefault("RATE_LIMIT_ENABLED", "false")
+	viper.SetDefault("RATE_LIMIT_REQUESTS_PER_SECOND", "10")
+	viper.SetDefault("RATE_LIMIT_BURST", "20")
+	viper.SetDefault("RATE_LIMIT_MODE", constants.RateLimitModeWait)
+	viper.SetDefault("RATE_LIMIT_MAX_WAIT_MS", "2000")
+	viper.SetDefault("IMAGE_PROXY_REWRITE_URLS", "false")
+	viper.SetDefault("IMAGE_PROXY_BASE_URL", "")
+	viper.SetDefault("IMAGE_PROXY_ALLOWED_HOSTS", "")
+	viper.SetDefault("IMAGE_CACHE_DIR", "/tmp/mangindo-feeder/images")
+	viper.SetDefault("IMAGE_CACHE_TTL_HOURS", "168")
+	viper.SetDefault("IMAGE_CACHE_MAX_SIZE_MB", "1024")
+	viper.SetDefault("MANGA_CACHE_EXPIRATION_MN", "60")
+	viper.SetDefault("MANGA_CACHE_HARD_EXPIRATION_MN", "1440")
+	viper.SetDefault("CHAPTER_CACHE_EXPIRATION_MN", "30")
+	viper.SetDefault("CHAPTER_CACHE_HARD_EXPIRATION_MN", "1440")
+	viper.SetDefault("CONTENT_CACHE_EXPIRATION_MN", "2880")
+	viper.SetDefault("CONTENT_CACHE_HARD_EXPIRATION_MN", "10080")
+	viper.SetDefault("COMPLETED_CHAPTER_CACHE_EXPIRATION_MN", "4320")
+	viper.SetDefault("COMPLETED_CHAPTER_CACHE_HARD_EXPIRATION_MN", "20160")
+	viper.SetDefault("POPULAR_CHAPTER_CACHE_EXPIRATION_MN", "10")
+	viper.SetDefault("POPULAR_CHAPTER_CACHE_HARD_EXPIRATION_MN", "1440")
+	viper.SetDefault("CHAPTER_CACHE_TITLE_EXPIRATIONS_MN", "")
+	viper.SetDefault("CACHE_CODEC_FORMAT", constants.CacheFormatJSON)
+	viper.SetDefault("CACHE_CODEC_COMPRESSION", constants.CacheCompressionNone)
+	viper.SetDefault("LOCAL_CACHE_MAX_ENTRIES", "512")
+	viper.SetDefault("LOCAL_CACHE_MAX_AGE_SEC", "300")
+	viper.SetDefault("COMPRESSION_MIN_SIZE_BYTES", "1024")
+	viper.SetDefault("COMPRESSION_GZIP_LEVEL", "6")
+	viper.SetDefault("COMPRESSION_BROTLI_LEVEL", "5")
 	viper.AutomaticEnv()
 
 	viper.SetConfigName("application")
@@ -35,20 +163,65 @@ func Load() {
 
 	appConfig = &Config{
 		port:               getIntOrPanic("APP_PORT"),
+		requestTimeout:     getPositiveDurationInMs("REQUEST_TIMEOUT_MS"),
 		logLevel:           fatalGetString("LOG_LEVEL"),
 		redisHost:          fatalGetString("REDIS_HOST"),
 		redisPort:          getIntOrPanic("REDIS_PORT"),
 		redisPool:          getIntOrPanic("REDIS_POOL"),
 		workerRedisAddress: fatalGetString("WORKER_REDIS_ADDRESS"),
 		baseURL:            fatalGetString("ORIGIN_SERVER_BASE_URL"),
-		popularMangaTags:   fatalGetStringArray("POPULAR_MANGA_TAGS", ", "),
-		adsContentTags:     fatalGetStringArray("ADS_CONTENT_TAGS", ", "),
+		originSource:       fatalGetString("ORIGIN_SOURCE"),
+		originConfig: OriginConfig{
+			UserAgent:       fatalGetString("ORIGIN_USER_AGENT"),
+			ExtraHeaders:    getHeaders("ORIGIN_EXTRA_HEADERS"),
+			MaxResponseSize: int64(getIntOrPanic("ORIGIN_MAX_RESPONSE_BYTES")),
+		},
+		popularMangaTags: fatalGetStringArray("POPULAR_MANGA_TAGS", ", "),
+		adsContentTags:   fatalGetStringArray("ADS_CONTENT_TAGS", ", "),
 		hystrixConfig: heimdall.HystrixCommandConfig{
 			Timeout:               getIntOrPanic("HYSTRIX_TIMEOUT_MS"),
 			MaxConcurrentRequests: getIntOrPanic("HYSTRIX_MAX_CONCURRENT_REQUESTS"),
 			SleepWindow:           getIntOrPanic("HYSTRIX_SLEEP_WINDOW_MS"),
 			ErrorPercentThreshold: getIntOrPanic("HYSTRIX_ERROR_THRESHOLD"),
 		},
+		imageProxyConfig: ImageProxyConfig{
+			RewriteURLs:  getBoolOrPanic("IMAGE_PROXY_REWRITE_URLS"),
+			BaseURL:      strings.TrimSuffix(fatalGetString("IMAGE_PROXY_BASE_URL"), "/"),
+			AllowedHosts: getImageHosts("IMAGE_PROXY_ALLOWED_HOSTS"),
+			CacheDir:     fatalGetString("IMAGE_CACHE_DIR"),
+			CacheTTL:     time.Duration(getIntOrPanic("IMAGE_CACHE_TTL_HOURS")) * time.Hour,
+			CacheMaxSize: int64(getIntOrPanic("IMAGE_CACHE_MAX_SIZE_MB")) << 20,
+		},
+		cacheTTLConfig: loadCacheTTLConfig(),
+		cacheCodecConfig: CacheCodecConfig{
+			Format:      getOneOf("CACHE_CODEC_FORMAT", constants.CacheFormatJSON, constants.CacheFormatMsgpack),
+			Compression: getOneOf("CACHE_CODEC_COMPRESSION", constants.CacheCompressionNone, constants.CacheCompressionGzip, constants.CacheCompressionZstd),
+		},
+		localCacheConfig: LocalCacheConfig{
+			MaxEntries: getIntOrPanic("LOCAL_CACHE_MAX_ENTRIES"),
+			MaxAge:     time.Duration(getIntOrPanic("LOCAL_CACHE_MAX_AGE_SEC")) * time.Second,
+		},
+		compressionConfig: CompressionConfig{
+			MinSize:     getIntOrPanic("COMPRESSION_MIN_SIZE_BYTES"),
+			GzipLevel:   getIntOrPanic("COMPRESSION_GZIP_LEVEL"),
+			BrotliLevel: getIntOrPanic("COMPRESSION_BROTLI_LEVEL"),
+		},
+		retryConfigs: loadRetryConfigs(
+			constants.GetMangaListCommand,
+			constants.GetChapterListCommand,
+			constants.GetContentListCommand,
+		),
+		rateLimitConfigs: loadRateLimitConfigs(
+			constants.GetMangaListCommand,
+			constants.GetChapterListCommand,
+			constants.GetContentListCommand,
+			constants.GetHTMLPageCommand,
+			constants.GetImageCommand,
+		),
+		htmlFallbacks: loadHTMLFallbacks(
+			constants.GetChapterListCommand,
+			constants.GetContentListCommand,
+		),
 	}
 }
 
@@ -56,6 +229,12 @@ func Port() int {
 	return appConfig.port
 }
 
+// RequestTimeout bounds how long an API request may take, origin retries and
+// rate limit waits included.
+func RequestTimeout() time.Duration {
+	return appConfig.requestTimeout
+}
+
 func LogLevel() string {
 	return appConfig.logLevel
 }
@@ -80,10 +259,28 @@ func BaseURL() string {
 	return appConfig.baseURL
 }
 
+func OriginSource() string {
+	return appConfig.originSource
+}
+
+func Origin() OriginConfig {
+	return appConfig.originConfig
+}
+
 func PopularMangaTags() []string {
 	return appConfig.popularMangaTags
 }
 
+// IsPopularManga tells whether a title is one of the popular manga tags.
+func IsPopularManga(titleID string) bool {
+	for _, tag := range appConfig.popularMangaTags {
+		if titleID == tag {
+			return true
+		}
+	}
+	return false
+}
+
 func AdsContentTags() []string {
 	return appConfig.adsContentTags
 }
@@ -91,3 +288,45 @@ func AdsContentTags() []string {
 func HystrixConfig() heimdall.HystrixCommandConfig {
 	return appConfig.hystrixConfig
 }
+
+func ImageProxy() ImageProxyConfig {
+	return appConfig.imageProxyConfig
+}
+
+func CacheTTLs() CacheTTLConfig {
+	return appConfig.cacheTTLConfig
+}
+
+func CacheCodec() CacheCodecConfig {
+	return appConfig.cacheCodecConfig
+}
+
+func LocalCache() LocalCacheConfig {
+	return appConfig.localCacheConfig
+}
+
+func Compression() CompressionConfig {
+	return appConfig.compressionConfig
+}
+
+// RetryPolicy returns the retry config of a hystrix command, falling back to
+// the default RETRY_* keys for values that are not overridden.
+func RetryPolicy(command string) RetryConfig {
+	if rc, ok := appConfig.retryConfigs[command]; ok {
+		return rc
+	}
+	return appConfig.retryConfigs[""]
+}
+
+func RateLimitPolicy(command string) RateLimitConfig {
+	if rc, ok := appConfig.rateLimitConfigs[command]; ok {
+		return rc
+	}
+	return appConfig.rateLimitConfigs[""]
+}
+
+// HTMLFallbackEnabled tells whether a hystrix command may fall back to
+// scraping the origin website when its JSON endpoint returns nothing.
+func HTMLFallbackEnabled(command string) bool {
+	return appConfig.htmlFallbacks[command]
+}
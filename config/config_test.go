@@ -3,22 +3,35 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestConfig(t *testing.T) {
 	configVars := map[string]string{
-		"APP_PORT":               "3001",
-		"LOG_LEVEL":              "debug",
-		"ENVIRONMENT":            "test",
-		"REDIS_HOST":             "localhost",
-		"REDIS_PORT":             "6379",
-		"REDIS_POOL":             "10",
-		"WORKER_REDIS_ADDRESS":   "127.0.0.1:6379",
-		"ORIGIN_SERVER_BASE_URL": "https://foo.com",
-		"POPULAR_MANGA_TAGS":     "foo1, foo2",
-		"ADS_CONTENT_TAGS":       "foo1, foo2",
+		"APP_PORT":                  "3001",
+		"LOG_LEVEL":                 "debug",
+		"ENVIRONMENT":               "test",
+		"REDIS_HOST":                "localhost",
+		"REDIS_PORT":                "6379",
+		"REDIS_POOL":                "10",
+		"WORKER_REDIS_ADDRESS":      "127.0.0.1:6379",
+		"ORIGIN_SERVER_BASE_URL":    "https://foo.com",
+		"ORIGIN_SOURCE":             "foo",
+		"ORIGIN_EXTRA_HEADERS":      "Referer: https://foo.com/; X-Foo: bar, baz; broken",
+		"IMAGE_PROXY_BASE_URL":      "https://bar.com/",
+		"IMAGE_PROXY_ALLOWED_HOSTS": "www.foo.com, IMG.foo.com,",
+		"POPULAR_MANGA_TAGS":        "foo1, foo2",
+		"ADS_CONTENT_TAGS":          "foo1, foo2",
+
+		"GET_CHAPTER_LIST_RETRY_MAX_ATTEMPTS":    "5",
+		"GET_CONTENT_LIST_HTML_FALLBACK_ENABLED": "true",
+		"GET_IMAGE_RATE_LIMIT_ENABLED":           "true",
+		"GET_IMAGE_RATE_LIMIT_MODE":              "reject",
+		"CHAPTER_CACHE_TITLE_EXPIRATIONS_MN":     "foo1: 5; foo2: 20160/40320",
 	}
 
 	for k, v := range configVars {
@@ -28,12 +41,61 @@ func TestConfig(t *testing.T) {
 
 	Load()
 	assert.Equal(t, 3001, Port())
+	assert.Equal(t, 30*time.Second, RequestTimeout())
 	assert.Equal(t, configVars["LOG_LEVEL"], LogLevel())
 	assert.Equal(t, configVars["REDIS_HOST"], RedisHost())
 	assert.Equal(t, 6379, RedisPort())
 	assert.Equal(t, 10, RedisPool())
 	assert.Equal(t, configVars["WORKER_REDIS_ADDRESS"], WorkerRedisAddress())
 	assert.Equal(t, configVars["ORIGIN_SERVER_BASE_URL"], BaseURL())
+	assert.Equal(t, configVars["ORIGIN_SOURCE"], OriginSource())
+	assert.Equal(t, OriginConfig{
+		UserAgent:       "mangindo-feeder",
+		ExtraHeaders:    map[string]string{"Referer": "https://foo.com/", "X-Foo": "bar, baz"},
+		MaxResponseSize: 10485760,
+	}, Origin())
 	assert.Equal(t, []string{"foo1", "foo2"}, PopularMangaTags())
 	assert.Equal(t, []string{"foo1", "foo2"}, AdsContentTags())
+	assert.Equal(t, RetryConfig{
+		MaxAttempts:       3,
+		InitialBackoff:    100 * time.Millisecond,
+		MaxBackoff:        2 * time.Second,
+		BackoffMultiplier: 2,
+		MaxJitter:         50 * time.Millisecond,
+	}, RetryPolicy(constants.GetMangaListCommand))
+	assert.Equal(t, 5, RetryPolicy(constants.GetChapterListCommand).MaxAttempts)
+	assert.Equal(t, 3, RetryPolicy("foo").MaxAttempts)
+	assert.Equal(t, RateLimitConfig{
+		Enabled:           false,
+		RequestsPerSecond: 10,
+		Burst:             20,
+		Mode:              constants.RateLimitModeWait,
+		MaxWait:           2 * time.Second,
+	}, RateLimitPolicy(constants.GetMangaListCommand))
+	assert.True(t, RateLimitPolicy(constants.GetImageCommand).Enabled)
+	assert.Equal(t, constants.RateLimitModeReject, RateLimitPolicy(constants.GetImageCommand).Mode)
+	assert.False(t, RateLimitPolicy("foo").Enabled)
+	assert.False(t, HTMLFallbackEnabled(constants.GetChapterListCommand))
+	assert.True(t, HTMLFallbackEnabled(constants.GetContentListCommand))
+	assert.False(t, HTMLFallbackEnabled(constants.GetMangaListCommand))
+	assert.Equal(t, ImageProxyConfig{
+		RewriteURLs:  false,
+		BaseURL:      "https://bar.com",
+		AllowedHosts: []string{"foo.com", "www.foo.com", "img.foo.com"},
+		CacheDir:     "/tmp/mangindo-feeder/images",
+		CacheTTL:     168 * time.Hour,
+		CacheMaxSize: 1024 << 20,
+	}, ImageProxy())
+	assert.Equal(t, CacheTTL{Expiration: time.Hour, HardExpiration: 24 * time.Hour}, CacheTTLs().Manga)
+	assert.Equal(t, CacheTTL{Expiration: 30 * time.Minute, HardExpiration: 24 * time.Hour}, CacheTTLs().Chapter)
+	assert.Equal(t, CacheTTL{Expiration: 48 * time.Hour, HardExpiration: 7 * 24 * time.Hour}, CacheTTLs().Content)
+	assert.Equal(t, CacheTTL{Expiration: 72 * time.Hour, HardExpiration: 14 * 24 * time.Hour}, CacheTTLs().CompletedChapter)
+	assert.Equal(t, CacheTTL{Expiration: 10 * time.Minute, HardExpiration: 24 * time.Hour}, CacheTTLs().PopularChapter)
+	assert.Equal(t, map[string]CacheTTL{
+		"foo1": {Expiration: 5 * time.Minute, HardExpiration: 24 * time.Hour},
+		"foo2": {Expiration: 14 * 24 * time.Hour, HardExpiration: 28 * 24 * time.Hour},
+	}, CacheTTLs().TitleChapters)
+	assert.Equal(t, CacheCodecConfig{Format: "json", Compression: "none"}, CacheCodec())
+	assert.Equal(t, LocalCacheConfig{MaxEntries: 512, MaxAge: 5 * time.Minute}, LocalCache())
+	assert.Equal(t, CompressionConfig{MinSize: 1024, GzipLevel: 6, BrotliLevel: 5}, Compression())
 }
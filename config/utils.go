@@ -2,10 +2,14 @@ package config
 
 import (
 	"log"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/spf13/viper"
 )
 
@@ -34,6 +38,21 @@ func fatalGetStringArray(key, sep string) []string {
 	return strings.Split(value, sep)
 }
 
+// getImageHosts returns the origin host followed by the comma separated
+// image hosts in key, all lower cased.
+func getImageHosts(key string) []string {
+	hosts := []string{}
+	if u, err := url.Parse(fatalGetString("ORIGIN_SERVER_BASE_URL")); err == nil && u.Host != "" {
+		hosts = append(hosts, strings.ToLower(u.Host))
+	}
+	for _, h := range strings.Split(fatalGetString(key), ",") {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
 func checkKey(key string) {
 	if !viper.IsSet(key) && os.Getenv(key) == "" {
 		log.Fatalf("%s key is not set", key)
@@ -45,3 +64,211 @@ func panicIfErrorForKey(err error, key string) {
 		log.Fatalf("Could not parse key: %s, Error: %s", key, err)
 	}
 }
+
+func loadRetryConfigs(commands ...string) map[string]RetryConfig {
+	rcs := map[string]RetryConfig{"": loadRetryConfig("")}
+	for _, command := range commands {
+		rcs[command] = loadRetryConfig(getCommandKeyPrefix(command))
+	}
+	return rcs
+}
+
+func loadRetryConfig(prefix string) RetryConfig {
+	return RetryConfig{
+		MaxAttempts:       getIntOrPanic(getCommandKey(prefix, "RETRY_MAX_ATTEMPTS")),
+		InitialBackoff:    getDurationInMs(getCommandKey(prefix, "RETRY_INITIAL_BACKOFF_MS")),
+		MaxBackoff:        getDurationInMs(getCommandKey(prefix, "RETRY_MAX_BACKOFF_MS")),
+		BackoffMultiplier: getFloatOrPanic(getCommandKey(prefix, "RETRY_BACKOFF_MULTIPLIER")),
+		MaxJitter:         getDurationInMs(getCommandKey(prefix, "RETRY_MAX_JITTER_MS")),
+	}
+}
+
+func loadRateLimitConfigs(commands ...string) map[string]RateLimitConfig {
+	rcs := map[string]RateLimitConfig{"": loadRateLimitConfig("")}
+	for _, command := range commands {
+		rcs[command] = loadRateLimitConfig(getCommandKeyPrefix(command))
+	}
+	return rcs
+}
+
+func loadRateLimitConfig(prefix string) RateLimitConfig {
+	modeKey := getCommandKey(prefix, "RATE_LIMIT_MODE")
+	mode := fatalGetString(modeKey)
+	if mode != constants.RateLimitModeWait && mode != constants.RateLimitModeReject {
+		log.Fatalf("Could not parse key: %s, Error: unknown mode %q", modeKey, mode)
+	}
+
+	rateKey := getCommandKey(prefix, "RATE_LIMIT_REQUESTS_PER_SECOND")
+	rate := getFloatOrPanic(rateKey)
+	if rate <= 0 {
+		log.Fatalf("Could not parse key: %s, Error: must be greater than 0", rateKey)
+	}
+
+	burstKey := getCommandKey(prefix, "RATE_LIMIT_BURST")
+	burst := getIntOrPanic(burstKey)
+	if burst < 1 {
+		log.Fatalf("Could not parse key: %s, Error: must be at least 1", burstKey)
+	}
+
+	return RateLimitConfig{
+		Enabled:           getBoolOrPanic(getCommandKey(prefix, "RATE_LIMIT_ENABLED")),
+		RequestsPerSecond: rate,
+		Burst:             burst,
+		Mode:              mode,
+		MaxWait:           getDurationInMs(getCommandKey(prefix, "RATE_LIMIT_MAX_WAIT_MS")),
+	}
+}
+
+// getCommandKey prefers the command specific override of key when it is set.
+func getCommandKey(prefix, key string) string {
+	if prefix != "" && (viper.IsSet(prefix+key) || os.Getenv(prefix+key) != "") {
+		return prefix + key
+	}
+	return key
+}
+
+// getCommandKeyPrefix turns a command name like GetMangaListCommand into
+// the GET_MANGA_LIST_ config key prefix.
+func getCommandKeyPrefix(command string) string {
+	var sb strings.Builder
+	for i, r := range strings.TrimSuffix(command, "Command") {
+		if i > 0 && unicode.IsUpper(r) {
+			sb.WriteRune('_')
+		}
+		sb.WriteRune(unicode.ToUpper(r))
+	}
+	sb.WriteRune('_')
+	return sb.String()
+}
+
+func loadHTMLFallbacks(commands ...string) map[string]bool {
+	fallbacks := map[string]bool{}
+	for _, command := range commands {
+		fallbacks[command] = getBoolOrPanic(getCommandKeyPrefix(command) + "HTML_FALLBACK_ENABLED")
+	}
+	return fallbacks
+}
+
+func loadCacheTTLConfig() CacheTTLConfig {
+	chapter := loadCacheTTL("CHAPTER_")
+	return CacheTTLConfig{
+		Manga:            loadCacheTTL("MANGA_"),
+		Chapter:          chapter,
+		Content:          loadCacheTTL("CONTENT_"),
+		CompletedChapter: loadCacheTTL("COMPLETED_CHAPTER_"),
+		PopularChapter:   loadCacheTTL("POPULAR_CHAPTER_"),
+		TitleChapters:    getCacheTTLOverrides("CHAPTER_CACHE_TITLE_EXPIRATIONS_MN", chapter),
+	}
+}
+
+func loadCacheTTL(prefix string) CacheTTL {
+	ttl := CacheTTL{
+		Expiration:     getDurationInMn(prefix + "CACHE_EXPIRATION_MN"),
+		HardExpiration: getDurationInMn(prefix + "CACHE_HARD_EXPIRATION_MN"),
+	}
+	if ttl.HardExpiration < ttl.Expiration {
+		log.Fatalf("Could not parse key: %sCACHE_HARD_EXPIRATION_MN, Error: shorter than the expiration", prefix)
+	}
+	return ttl
+}
+
+// getCacheTTLOverrides parses an optional "title: expiration/hard expiration"
+// list in minutes, separated by semicolons. The hard expiration may be left
+// out, then it is the one of fallback, or the expiration when that is longer.
+func getCacheTTLOverrides(key string, fallback CacheTTL) map[string]CacheTTL {
+	value := os.Getenv(key)
+	if value == "" {
+		value = viper.GetString(key)
+	}
+
+	overrides := map[string]CacheTTL{}
+	for _, entry := range strings.Split(value, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			log.Fatalf("Could not parse key: %s, Error: invalid entry %q", key, entry)
+		}
+
+		minutes := strings.SplitN(parts[1], "/", 2)
+		expiration, err := strconv.Atoi(strings.TrimSpace(minutes[0]))
+		panicIfErrorForKey(err, key)
+
+		ttl := CacheTTL{
+			Expiration:     time.Duration(expiration) * time.Minute,
+			HardExpiration: fallback.HardExpiration,
+		}
+		if len(minutes) == 2 {
+			hardExpiration, err := strconv.Atoi(strings.TrimSpace(minutes[1]))
+			panicIfErrorForKey(err, key)
+			ttl.HardExpiration = time.Duration(hardExpiration) * time.Minute
+		}
+		if ttl.HardExpiration < ttl.Expiration {
+			ttl.HardExpiration = ttl.Expiration
+		}
+		overrides[strings.TrimSpace(parts[0])] = ttl
+	}
+	return overrides
+}
+
+func getOneOf(key string, values ...string) string {
+	value := fatalGetString(key)
+	for _, v := range values {
+		if value == v {
+			return value
+		}
+	}
+	log.Fatalf("Could not parse key: %s, Error: unknown value %q", key, value)
+	return ""
+}
+
+func getDurationInMn(key string) time.Duration {
+	return time.Duration(getIntOrPanic(key)) * time.Minute
+}
+
+func getDurationInMs(key string) time.Duration {
+	return time.Duration(getIntOrPanic(key)) * time.Millisecond
+}
+
+func getPositiveDurationInMs(key string) time.Duration {
+	d := getDurationInMs(key)
+	if d <= 0 {
+		log.Fatalf("Could not parse key: %s, Error: must be greater than 0", key)
+	}
+	return d
+}
+
+func getBoolOrPanic(key string) bool {
+	checkKey(key)
+	v, err := strconv.ParseBool(fatalGetString(key))
+	panicIfErrorForKey(err, key)
+	return v
+}
+
+func getFloatOrPanic(key string) float64 {
+	checkKey(key)
+	v, err := strconv.ParseFloat(fatalGetString(key), 64)
+	panicIfErrorForKey(err, key)
+	return v
+}
+
+// getHeaders parses an optional "Name: value; Name: value" list. Entries
+// without a colon are ignored.
+func getHeaders(key string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		value = viper.GetString(key)
+	}
+
+	headers := map[string]string{}
+	for _, entry := range strings.Split(value, ";") {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+		headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return headers
+}
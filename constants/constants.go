@@ -10,24 +10,78 @@ const (
 	GetMangaListCommand   = "GetMangaListCommand"
 	GetChapterListCommand = "GetChapterListCommand"
 	GetContentListCommand = "GetContentListCommand"
+	GetHTMLPageCommand    = "GetHTMLPageCommand"
+	GetImageCommand       = "GetImageCommand"
 
-	GetMangasAPIPath   = "/mangindo/v1/mangas"
-	GetChaptersAPIPath = "/mangindo/v1/mangas/{title_id}/chapters"
-	GetContentsAPIPath = "/mangindo/v1/mangas/{title_id}/chapters/{chapter}/contents"
+	MangacanSource = "mangacan"
 
-	TitleIDKeyParam = "title_id"
-	ChapterKeyParam = "chapter"
+	GetMangasAPIPath            = "/mangindo/v1/mangas"
+	GetMangaAPIPath             = "/mangindo/v1/mangas/{title_id}"
+	GetChaptersAPIPath          = "/mangindo/v1/mangas/{title_id}/chapters"
+	GetContentsAPIPath          = "/mangindo/v1/mangas/{title_id}/chapters/{chapter}/contents"
+	GetBatchContentsAPIPath     = "/mangindo/v1/mangas/{title_id}/contents"
+	SearchAPIPath               = "/mangindo/v1/search"
+	GetGenresAPIPath            = "/mangindo/v1/genres"
+	SchemaDiagnosticsAPIPath    = "/mangindo/v1/diagnostics/schema"
+	RateLimitDiagnosticsAPIPath = "/mangindo/v1/diagnostics/rate_limits"
+	ImageProxyAPIPath           = "/mangindo/v1/images"
+	GetImageAPIPath             = ImageProxyAPIPath + "/{path:.+}"
 
-	MangaCacheExpirationInMn   = 60
-	ChapterCacheExpirationInMn = 30
-	ContentCacheExpirationInMn = 60 * 48
+	GetMangasV2APIPath   = "/mangindo/v2/mangas"
+	GetMangaV2APIPath    = "/mangindo/v2/mangas/{title_id}"
+	GetChaptersV2APIPath = "/mangindo/v2/mangas/{title_id}/chapters"
+	GetContentsV2APIPath = "/mangindo/v2/mangas/{title_id}/chapters/{chapter}/contents"
+	GetGenresV2APIPath   = "/mangindo/v2/genres"
+	SearchV2APIPath      = "/mangindo/v2/search"
+
+	TitleIDKeyParam   = "title_id"
+	ChapterKeyParam   = "chapter"
+	ChaptersKeyParam  = "chapters"
+	ImagePathKeyParam = "path"
+	PageKeyParam      = "page"
+	LimitKeyParam     = "limit"
+	OrderKeyParam     = "order"
+	FromKeyParam      = "from"
+	ToKeyParam        = "to"
+	QueryKeyParam     = "q"
+	GenreKeyParam     = "genre"
+	GenreMatchParam   = "genre_match"
+
+	ChapterOrderAsc     = "asc"
+	ChapterOrderDesc    = "desc"
+	MaxChapterPageLimit = 100
+	MaxSearchResults    = 50
+
+	MaxBatchContentChapters = 20
+	BatchContentConcurrency = 4
+
+	GenreMatchAll = "all"
+	GenreMatchAny = "any"
+
+	CacheRefreshLockExpirationInSec = 60
+
+	SearchIndexCacheExpirationInMn = 60 * 24
+
+	RateLimitModeWait   = "wait"
+	RateLimitModeReject = "reject"
+
+	CacheFormatJSON      = "json"
+	CacheFormatMsgpack   = "msgpack"
+	CacheCompressionNone = "none"
+	CacheCompressionGzip = "gzip"
+	CacheCompressionZstd = "zstd"
 
 	SetMangaCacheJob   = "SetMangaCacheJob"
 	SetChapterCacheJob = "SetChapterCacheJob"
 	SetContentCacheJob = "SetContentCacheJob"
+	SetContentMetaJob  = "SetContentMetaJob"
+	SetSearchIndexJob  = "SetSearchIndexJob"
 
 	JobArgTitleID = "JobArg_TitleId"
 	JobArgChapter = "JobArg_Chapter"
 
 	NullText = "null"
+
+	OriginTimeLayout   = "2006-01-02 15:04:05"
+	OriginTimeLocation = "Asia/Jakarta"
 )
@@ -1,6 +1,23 @@
 package contract
 
+import (
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/common"
+)
+
 type ErrorResponse struct {
 	Success bool   `json:"success"`
 	Error   string `json:"error"`
 }
+
+func getLatestOriginTime(dates ...string) time.Time {
+	var latest time.Time
+	for _, d := range dates {
+		t, err := common.ParseOriginTime(d)
+		if err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
@@ -0,0 +1,49 @@
+package contract
+
+import (
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+type BatchContentRequest struct {
+	TitleID  string
+	Chapters []domain.ChapterID
+	From     *domain.ChapterID
+	To       *domain.ChapterID
+}
+
+type BatchContent struct {
+	Chapter    string    `json:"chapter"`
+	Success    bool      `json:"success"`
+	Contents   []Content `json:"contents"`
+	TotalPages int       `json:"total_pages"`
+	Error      string    `json:"error,omitempty"`
+}
+
+type BatchContentResponse struct {
+	Success bool           `json:"success"`
+	TitleID string         `json:"title_id"`
+	Results []BatchContent `json:"results"`
+}
+
+func NewBatchContentRequest(titleID, chapters, from, to string) BatchContentRequest {
+	req := BatchContentRequest{
+		TitleID:  titleID,
+		Chapters: []domain.ChapterID{},
+		From:     parseChapterBound(from),
+		To:       parseChapterBound(to),
+	}
+
+	seen := map[domain.ChapterID]bool{}
+	for _, c := range strings.Split(chapters, ",") {
+		id, err := domain.ParseChapterID(c)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		req.Chapters = append(req.Chapters, id)
+	}
+
+	return req
+}
@@ -0,0 +1,34 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchContentRequestTestSuite struct {
+	suite.Suite
+}
+
+func TestBatchContentRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchContentRequestTestSuite))
+}
+
+func (s *BatchContentRequestTestSuite) TestNewBatchContentRequest_ReturnsUniqueChapters() {
+	req := NewBatchContentRequest("bleach", "1, 2.5,foo,1,", "", "")
+
+	assert.Equal(s.T(), "bleach", req.TitleID)
+	assert.Equal(s.T(), []domain.ChapterID{"1", "2.5"}, req.Chapters)
+	assert.Nil(s.T(), req.From)
+	assert.Nil(s.T(), req.To)
+}
+
+func (s *BatchContentRequestTestSuite) TestNewBatchContentRequest_ReturnsRange() {
+	req := NewBatchContentRequest("bleach", "", "10", "12.5")
+
+	assert.Empty(s.T(), req.Chapters)
+	assert.Equal(s.T(), domain.ChapterID("10"), *req.From)
+	assert.Equal(s.T(), domain.ChapterID("12.5"), *req.To)
+}
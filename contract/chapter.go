@@ -1,20 +1,114 @@
 package contract
 
+import (
+	"strconv"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
 type ChapterRequest struct {
 	TitleID string
+	Page    int
+	Limit   int
+	Order   string
+	From    *domain.ChapterID
+	To      *domain.ChapterID
 }
 
 type Chapter struct {
 	Number  string `json:"number"`
 	Title   string `json:"title"`
 	TitleID string `json:"title_id"`
+
+	ModifiedDate string `json:"-"`
 }
 
 type ChapterResponse struct {
 	Success  bool      `json:"success"`
 	Chapters []Chapter `json:"chapters"`
+	Total    int       `json:"total"`
+	Page     int       `json:"page"`
+	Limit    int       `json:"limit"`
+	NextPage *int      `json:"next_page"`
+
+	Complete bool `json:"-"`
+}
+
+// LastModified is only known when the response holds every chapter of the
+// title. It is zero for paged or ranged responses.
+func (r ChapterResponse) LastModified() time.Time {
+	if !r.Complete {
+		return time.Time{}
+	}
+
+	dates := []string{}
+	for _, c := range r.Chapters {
+		dates = append(dates, c.ModifiedDate)
+	}
+	return getLatestOriginTime(dates...)
 }
 
 func NewChapterRequest(titleID string) ChapterRequest {
-	return ChapterRequest{TitleID: titleID}
+	return ChapterRequest{
+		TitleID: titleID,
+		Page:    1,
+		Order:   constants.ChapterOrderDesc,
+	}
+}
+
+func NewPagedChapterRequest(titleID, page, limit, order, from, to string) ChapterRequest {
+	req := NewChapterRequest(titleID)
+
+	if p, err := strconv.Atoi(page); err == nil && p > 0 {
+		req.Page = p
+	}
+
+	if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+		req.Limit = l
+		if l > constants.MaxChapterPageLimit {
+			req.Limit = constants.MaxChapterPageLimit
+		}
+	}
+
+	if order == constants.ChapterOrderAsc {
+		req.Order = constants.ChapterOrderAsc
+	}
+
+	req.From = parseChapterBound(from)
+	req.To = parseChapterBound(to)
+
+	return req
+}
+
+func NewChapterResponse(req ChapterRequest, chapters []Chapter, total int) ChapterResponse {
+	cr := ChapterResponse{
+		Success:  true,
+		Chapters: chapters,
+		Total:    total,
+		Page:     req.Page,
+		Limit:    req.Limit,
+		Complete: req.Limit == 0 && req.From == nil && req.To == nil,
+	}
+
+	if req.Limit == 0 {
+		cr.Limit = total
+		return cr
+	}
+
+	if req.Page*req.Limit < total {
+		next := req.Page + 1
+		cr.NextPage = &next
+	}
+
+	return cr
+}
+
+func parseChapterBound(bound string) *domain.ChapterID {
+	b, err := domain.ParseChapterID(bound)
+	if err != nil {
+		return nil
+	}
+	return &b
 }
@@ -0,0 +1,72 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChapterRequestTestSuite struct {
+	suite.Suite
+}
+
+func TestChapterRequestTestSuite(t *testing.T) {
+	suite.Run(t, new(ChapterRequestTestSuite))
+}
+
+func (s *ChapterRequestTestSuite) TestNewPagedChapterRequest_ReturnsDefaultRequest_WhenParamsAreBlank() {
+	req := NewPagedChapterRequest("bleach", "", "", "", "", "")
+
+	assert.Equal(s.T(), NewChapterRequest("bleach"), req)
+}
+
+func (s *ChapterRequestTestSuite) TestNewPagedChapterRequest_ReturnsDefaultRequest_WhenParamsAreInvalid() {
+	req := NewPagedChapterRequest("bleach", "-1", "abc", "up", "x", "y")
+
+	assert.Equal(s.T(), NewChapterRequest("bleach"), req)
+}
+
+func (s *ChapterRequestTestSuite) TestNewPagedChapterRequest_CapsLimit_WhenLimitIsTooLarge() {
+	req := NewPagedChapterRequest("bleach", "2", "1000", "", "", "")
+
+	assert.Equal(s.T(), 2, req.Page)
+	assert.Equal(s.T(), constants.MaxChapterPageLimit, req.Limit)
+}
+
+func (s *ChapterRequestTestSuite) TestNewPagedChapterRequest_ReturnsValidRequest() {
+	req := NewPagedChapterRequest("bleach", "3", "20", "asc", "600", "657.5")
+
+	assert.Equal(s.T(), "bleach", req.TitleID)
+	assert.Equal(s.T(), 3, req.Page)
+	assert.Equal(s.T(), 20, req.Limit)
+	assert.Equal(s.T(), constants.ChapterOrderAsc, req.Order)
+	assert.Equal(s.T(), domain.ChapterID("600"), *req.From)
+	assert.Equal(s.T(), domain.ChapterID("657.5"), *req.To)
+}
+
+func (s *ChapterRequestTestSuite) TestNewChapterResponse_ReturnsWholeList_WhenLimitIsNotSet() {
+	cr := NewChapterResponse(NewChapterRequest("bleach"), []Chapter{{Number: "1"}, {Number: "2"}}, 2)
+
+	assert.Equal(s.T(), 2, cr.Total)
+	assert.Equal(s.T(), 1, cr.Page)
+	assert.Equal(s.T(), 2, cr.Limit)
+	assert.Nil(s.T(), cr.NextPage)
+}
+
+func (s *ChapterRequestTestSuite) TestNewChapterResponse_ReturnsNextPage_WhenMoreChaptersRemain() {
+	req := NewPagedChapterRequest("bleach", "1", "1", "", "", "")
+	cr := NewChapterResponse(req, []Chapter{{Number: "2"}}, 2)
+
+	assert.Equal(s.T(), 2, cr.Total)
+	assert.Equal(s.T(), 2, *cr.NextPage)
+}
+
+func (s *ChapterRequestTestSuite) TestNewChapterResponse_ReturnsNoNextPage_WhenOnLastPage() {
+	req := NewPagedChapterRequest("bleach", "2", "1", "", "", "")
+	cr := NewChapterResponse(req, []Chapter{{Number: "1"}}, 2)
+
+	assert.Nil(s.T(), cr.NextPage)
+}
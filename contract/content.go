@@ -1,29 +1,33 @@
 package contract
 
-import "strconv"
+import "github.com/bigscreen/mangindo-feeder/domain"
 
 type ContentRequest struct {
 	TitleID string
-	Chapter float32
+	Chapter domain.ChapterID
 }
 
 type ContentResponse struct {
-	Success  bool      `json:"success"`
-	Contents []Content `json:"contents"`
+	Success         bool      `json:"success"`
+	Contents        []Content `json:"contents"`
+	TotalPages      int       `json:"total_pages"`
+	PreviousChapter *Chapter  `json:"previous_chapter"`
+	NextChapter     *Chapter  `json:"next_chapter"`
 }
 
 type Content struct {
 	ImageURL string `json:"image_url"`
+	Page     int    `json:"page"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	Size     int64  `json:"size,omitempty"`
 }
 
 func NewContentRequest(titleID, chapter string) ContentRequest {
-	chapterF, err := strconv.ParseFloat(chapter, 32)
-	if err != nil {
-		chapterF = 0.0
-	}
+	chapterID, _ := domain.ParseChapterID(chapter)
 
 	return ContentRequest{
 		TitleID: titleID,
-		Chapter: float32(chapterF),
+		Chapter: chapterID,
 	}
 }
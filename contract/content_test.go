@@ -1,8 +1,10 @@
 package contract
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
@@ -16,25 +18,31 @@ func TestContentRequestSuiteSuite(t *testing.T) {
 }
 
 func (s *ContentRequestTestSuite) TestNewContentRequest_ReturnsContentRequestWithZeroChapter_WhenInvalidChapterIsBeingSet() {
-	req := NewContentRequest("bleach", "1bc")
+	req := NewContentRequest("bleach", "1.b")
 
 	assert.Equal(s.T(), "bleach", req.TitleID)
-	assert.Equal(s.T(), float32(0.0), req.Chapter)
+	assert.Equal(s.T(), domain.ChapterID(""), req.Chapter)
 }
 
-func (s *ContentRequestTestSuite) TestNewContentRequest_ReturnsContentRequestWithZeroLatLng_WhenUnparseableLatLngAreBeingSet() {
+func (s *ContentRequestTestSuite) TestNewContentRequest_KeepsAllDigits_WhenChapterIsVeryLong() {
 	badF := "142524353634252534526262625362625362526727257326573562536253625632563253625362145625362536256325632536" +
 		"253621425243536342525345262626253626253625267272573265735625353621425243536342525345262626253626253625267272" +
 		"5732657356253625362563256325362536214252435363425253452626262536262536252672725732657356253625362563256325.0"
 	req := NewContentRequest("bleach", badF)
 
 	assert.Equal(s.T(), "bleach", req.TitleID)
-	assert.Equal(s.T(), float32(0.0), req.Chapter)
+	assert.Equal(s.T(), domain.ChapterID(strings.TrimSuffix(badF, ".0")), req.Chapter)
+}
+
+func (s *ContentRequestTestSuite) TestNewContentRequest_ReturnsCanonicalChapter() {
+	req := NewContentRequest("bleach", "01001.550")
+
+	assert.Equal(s.T(), domain.ChapterID("1001.55"), req.Chapter)
 }
 
 func (s *ContentRequestTestSuite) TestNewContentRequest_ReturnsValidContentRequest() {
 	req := NewContentRequest("bleach", "650")
 
 	assert.Equal(s.T(), "bleach", req.TitleID)
-	assert.Equal(s.T(), float32(650.0), req.Chapter)
+	assert.Equal(s.T(), domain.ChapterID("650"), req.Chapter)
 }
@@ -0,0 +1,30 @@
+package contract
+
+import "time"
+
+type SchemaReport struct {
+	Endpoint         string            `json:"endpoint"`
+	HasDrift         bool              `json:"has_drift"`
+	UnknownFields    map[string]int    `json:"unknown_fields"`
+	MissingFields    map[string]int    `json:"missing_fields"`
+	MismatchedFields map[string]string `json:"mismatched_fields"`
+	CheckedAt        time.Time         `json:"checked_at"`
+	DriftDetectedAt  *time.Time        `json:"drift_detected_at"`
+}
+
+type SchemaDiagnosticsResponse struct {
+	Success bool           `json:"success"`
+	Schemas []SchemaReport `json:"schemas"`
+}
+
+type RateLimitReport struct {
+	Endpoint    string `json:"endpoint"`
+	Throttled   int64  `json:"throttled"`
+	Rejected    int64  `json:"rejected"`
+	TotalWaitMs int64  `json:"total_wait_ms"`
+}
+
+type RateLimitDiagnosticsResponse struct {
+	Success    bool              `json:"success"`
+	RateLimits []RateLimitReport `json:"rate_limits"`
+}
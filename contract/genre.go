@@ -0,0 +1,11 @@
+package contract
+
+type Genre struct {
+	Name       string `json:"name"`
+	MangaCount int    `json:"manga_count"`
+}
+
+type GenreResponse struct {
+	Success bool    `json:"success"`
+	Genres  []Genre `json:"genres"`
+}
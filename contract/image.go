@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"io"
+	"time"
+)
+
+// Image is a proxied origin image ready to be served. Content is an
+// io.ReadSeeker when the image comes from the cache, and a stream otherwise.
+// It may also implement io.Closer, in which case it must be closed after use.
+type Image struct {
+	Name       string
+	ModifiedAt time.Time
+	Content    io.Reader
+	MaxAge     time.Duration
+}
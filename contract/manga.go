@@ -1,20 +1,109 @@
 package contract
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/common"
+	"github.com/bigscreen/mangindo-feeder/constants"
+)
+
+type MangaRequest struct {
+	TitleID string
+}
+
+type MangaListRequest struct {
+	Genres     []string
+	GenreMatch string
+}
+
 type Manga struct {
-	Title       string `json:"title"`
-	TitleID     string `json:"title_id"`
-	IconURL     string `json:"icon_url"`
-	LastChapter string `json:"last_chapter"`
-	Genre       string `json:"genre"`
-	Alias       string `json:"alias"`
-	Author      string `json:"author"`
-	Status      string `json:"status"`
-	PublishYear string `json:"publish_date"`
-	Summary     string `json:"summary"`
+	Title       string   `json:"title"`
+	TitleID     string   `json:"title_id"`
+	IconURL     string   `json:"icon_url"`
+	LastChapter string   `json:"last_chapter"`
+	Genre       string   `json:"genre"`
+	Genres      []string `json:"genres"`
+	Alias       string   `json:"alias"`
+	Author      string   `json:"author"`
+	Status      string   `json:"status"`
+	PublishYear string   `json:"publish_date"`
+	Summary     string   `json:"summary"`
+
+	ModifiedDate string `json:"-"`
+}
+
+type MangaDetail struct {
+	Manga
+	ChapterCount  int      `json:"chapter_count"`
+	NewestChapter *Chapter `json:"newest_chapter"`
+}
+
+// MarshalJSON writes the modified date of the manga, which list responses
+// leave out, as part of the detail.
+func (md MangaDetail) MarshalJSON() ([]byte, error) {
+	type detail MangaDetail
+	return json.Marshal(struct {
+		detail
+		ModifiedDate string `json:"modified_date"`
+	}{detail(md), md.ModifiedDate})
 }
 
 type MangaResponse struct {
 	Success       bool    `json:"success"`
 	PopularMangas []Manga `json:"popular_mangas"`
 	LatestMangas  []Manga `json:"latest_mangas"`
+
+	Complete bool `json:"-"`
+}
+
+type MangaDetailResponse struct {
+	Success bool        `json:"success"`
+	Manga   MangaDetail `json:"manga"`
+}
+
+// LastModified is only known when the response holds the whole manga list.
+// It is zero for genre filtered responses.
+func (r MangaResponse) LastModified() time.Time {
+	if !r.Complete {
+		return time.Time{}
+	}
+
+	dates := []string{}
+	for _, m := range r.PopularMangas {
+		dates = append(dates, m.ModifiedDate)
+	}
+	for _, m := range r.LatestMangas {
+		dates = append(dates, m.ModifiedDate)
+	}
+	return getLatestOriginTime(dates...)
+}
+
+func (r MangaDetailResponse) LastModified() time.Time {
+	dates := []string{r.Manga.ModifiedDate}
+	if r.Manga.NewestChapter != nil {
+		dates = append(dates, r.Manga.NewestChapter.ModifiedDate)
+	}
+	return getLatestOriginTime(dates...)
+}
+
+func NewMangaRequest(titleID string) MangaRequest {
+	return MangaRequest{TitleID: titleID}
+}
+
+func NewMangaListRequest(genres []string, genreMatch string) MangaListRequest {
+	req := MangaListRequest{
+		Genres:     []string{},
+		GenreMatch: constants.GenreMatchAny,
+	}
+
+	for _, g := range genres {
+		req.Genres = append(req.Genres, common.ParseGenres(g)...)
+	}
+
+	if genreMatch == constants.GenreMatchAll {
+		req.GenreMatch = constants.GenreMatchAll
+	}
+
+	return req
 }
@@ -0,0 +1,30 @@
+package contract
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MangaContractTestSuite struct {
+	suite.Suite
+}
+
+func TestMangaContractTestSuite(t *testing.T) {
+	suite.Run(t, new(MangaContractTestSuite))
+}
+
+func (s *MangaContractTestSuite) TestMarshalJSON_WritesModifiedDateOfMangaDetail() {
+	m := Manga{TitleID: "bleach", ModifiedDate: "2019-04-12 13:05:59"}
+
+	lb, _ := json.Marshal(m)
+	db, err := json.Marshal(MangaDetail{Manga: m, ChapterCount: 2})
+
+	assert.Nil(s.T(), err)
+	assert.NotContains(s.T(), string(lb), "modified_date")
+	assert.Contains(s.T(), string(db), `"title_id":"bleach"`)
+	assert.Contains(s.T(), string(db), `"chapter_count":2`)
+	assert.Contains(s.T(), string(db), `"modified_date":"2019-04-12 13:05:59"`)
+}
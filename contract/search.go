@@ -0,0 +1,14 @@
+package contract
+
+type SearchRequest struct {
+	Query string
+}
+
+type SearchResponse struct {
+	Success bool    `json:"success"`
+	Mangas  []Manga `json:"mangas"`
+}
+
+func NewSearchRequest(query string) SearchRequest {
+	return SearchRequest{Query: query}
+}
@@ -0,0 +1,73 @@
+package v2
+
+import (
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+// Chapter keeps the canonical chapter ID next to its number, since IDs with
+// a suffix, like "10a", share the number of the chapter they follow.
+type Chapter struct {
+	ID         domain.ChapterID `json:"id"`
+	Number     float64          `json:"number"`
+	Title      string           `json:"title"`
+	TitleID    string           `json:"title_id"`
+	ModifiedAt *time.Time       `json:"modified_at"`
+}
+
+type ChapterResponse struct {
+	Success  bool      `json:"success"`
+	Chapters []Chapter `json:"chapters"`
+	Total    int       `json:"total"`
+	Page     int       `json:"page"`
+	Limit    int       `json:"limit"`
+	NextPage *int      `json:"next_page"`
+
+	Complete bool `json:"-"`
+}
+
+func NewChapter(c contract.Chapter) Chapter {
+	chapter := Chapter{
+		Title:      c.Title,
+		TitleID:    c.TitleID,
+		ModifiedAt: parseTime(c.ModifiedDate),
+	}
+	if id, err := domain.ParseChapterID(c.Number); err == nil {
+		chapter.ID = id
+		chapter.Number = id.Float64()
+	}
+	return chapter
+}
+
+func NewChapterResponse(cr contract.ChapterResponse) ChapterResponse {
+	chapters := []Chapter{}
+	for _, c := range cr.Chapters {
+		chapters = append(chapters, NewChapter(c))
+	}
+
+	return ChapterResponse{
+		Success:  cr.Success,
+		Chapters: chapters,
+		Total:    cr.Total,
+		Page:     cr.Page,
+		Limit:    cr.Limit,
+		NextPage: cr.NextPage,
+		Complete: cr.Complete,
+	}
+}
+
+// LastModified is only known when the response holds every chapter of the
+// title. It is zero for paged or ranged responses.
+func (r ChapterResponse) LastModified() time.Time {
+	if !r.Complete {
+		return time.Time{}
+	}
+
+	ts := []*time.Time{}
+	for _, c := range r.Chapters {
+		ts = append(ts, c.ModifiedAt)
+	}
+	return getLatestTime(ts...)
+}
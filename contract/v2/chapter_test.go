@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChapterContractTestSuite struct {
+	suite.Suite
+}
+
+func TestChapterContractTestSuite(t *testing.T) {
+	suite.Run(t, new(ChapterContractTestSuite))
+}
+
+func (s *ChapterContractTestSuite) TestNewChapter_ReturnsIDAndNumber() {
+	c := NewChapter(contract.Chapter{Number: "10.5", Title: "Bleach 10.5", TitleID: "bleach"})
+
+	assert.Equal(s.T(), domain.ChapterID("10.5"), c.ID)
+	assert.Equal(s.T(), 10.5, c.Number)
+}
+
+func (s *ChapterContractTestSuite) TestNewChapter_KeepsSuffixInID_WhenChapterIsSuffixed() {
+	c := NewChapter(contract.Chapter{Number: "10a", Title: "Bleach 10a", TitleID: "bleach"})
+
+	assert.Equal(s.T(), domain.ChapterID("10a"), c.ID)
+	assert.Equal(s.T(), 10.0, c.Number)
+}
+
+func (s *ChapterContractTestSuite) TestNewChapter_ReturnsZeroNumber_WhenChapterIsInvalid() {
+	c := NewChapter(contract.Chapter{Number: "foo", TitleID: "bleach"})
+
+	assert.True(s.T(), c.ID.IsZero())
+	assert.Equal(s.T(), 0.0, c.Number)
+}
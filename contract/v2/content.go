@@ -0,0 +1,30 @@
+package v2
+
+import "github.com/bigscreen/mangindo-feeder/contract"
+
+type ContentResponse struct {
+	Success         bool               `json:"success"`
+	Contents        []contract.Content `json:"contents"`
+	TotalPages      int                `json:"total_pages"`
+	PreviousChapter *Chapter           `json:"previous_chapter"`
+	NextChapter     *Chapter           `json:"next_chapter"`
+}
+
+func NewContentResponse(cr contract.ContentResponse) ContentResponse {
+	res := ContentResponse{
+		Success:    cr.Success,
+		Contents:   cr.Contents,
+		TotalPages: cr.TotalPages,
+	}
+
+	if cr.PreviousChapter != nil {
+		c := NewChapter(*cr.PreviousChapter)
+		res.PreviousChapter = &c
+	}
+	if cr.NextChapter != nil {
+		c := NewChapter(*cr.NextChapter)
+		res.NextChapter = &c
+	}
+
+	return res
+}
@@ -0,0 +1,31 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContentContractTestSuite struct {
+	suite.Suite
+}
+
+func TestContentContractTestSuite(t *testing.T) {
+	suite.Run(t, new(ContentContractTestSuite))
+}
+
+func (s *ContentContractTestSuite) TestNewContentResponse_ReturnsTypedNavigation() {
+	cr := NewContentResponse(contract.ContentResponse{
+		Success:         true,
+		Contents:        []contract.Content{{ImageURL: "http://foo.com/1.jpg", Page: 1}},
+		TotalPages:      1,
+		PreviousChapter: &contract.Chapter{Number: "9", TitleID: "bleach"},
+	})
+
+	assert.True(s.T(), cr.Success)
+	assert.Equal(s.T(), 1, cr.TotalPages)
+	assert.Equal(s.T(), 9.0, cr.PreviousChapter.Number)
+	assert.Nil(s.T(), cr.NextChapter)
+}
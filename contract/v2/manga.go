@@ -0,0 +1,154 @@
+package v2
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/common"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+type Manga struct {
+	Title       string             `json:"title"`
+	TitleID     string             `json:"title_id"`
+	IconURL     string             `json:"icon_url"`
+	LastChapter *float64           `json:"last_chapter"`
+	Genres      []string           `json:"genres"`
+	Alias       string             `json:"alias"`
+	Author      string             `json:"author"`
+	Status      domain.MangaStatus `json:"status"`
+	PublishYear *int               `json:"publish_year"`
+	Summary     string             `json:"summary"`
+	ModifiedAt  *time.Time         `json:"modified_at"`
+}
+
+type MangaDetail struct {
+	Manga
+	ChapterCount  int      `json:"chapter_count"`
+	NewestChapter *Chapter `json:"newest_chapter"`
+}
+
+type MangaResponse struct {
+	Success       bool    `json:"success"`
+	PopularMangas []Manga `json:"popular_mangas"`
+	LatestMangas  []Manga `json:"latest_mangas"`
+
+	Complete bool `json:"-"`
+}
+
+type MangaDetailResponse struct {
+	Success bool        `json:"success"`
+	Manga   MangaDetail `json:"manga"`
+}
+
+type SearchResponse struct {
+	Success bool    `json:"success"`
+	Mangas  []Manga `json:"mangas"`
+}
+
+func NewManga(m contract.Manga) Manga {
+	genres := m.Genres
+	if genres == nil {
+		genres = common.ParseGenres(m.Genre)
+	}
+
+	return Manga{
+		Title:       m.Title,
+		TitleID:     m.TitleID,
+		IconURL:     m.IconURL,
+		LastChapter: parseNumber(m.LastChapter),
+		Genres:      genres,
+		Alias:       m.Alias,
+		Author:      m.Author,
+		Status:      domain.NewMangaStatus(m.Status),
+		PublishYear: parseYear(m.PublishYear),
+		Summary:     m.Summary,
+		ModifiedAt:  parseTime(m.ModifiedDate),
+	}
+}
+
+func NewMangas(ms []contract.Manga) []Manga {
+	mangas := []Manga{}
+	for _, m := range ms {
+		mangas = append(mangas, NewManga(m))
+	}
+	return mangas
+}
+
+func NewMangaDetail(md contract.MangaDetail) MangaDetail {
+	detail := MangaDetail{
+		Manga:        NewManga(md.Manga),
+		ChapterCount: md.ChapterCount,
+	}
+	if t := parseTime(md.ModifiedDate); t != nil {
+		detail.ModifiedAt = t
+	}
+
+	if md.NewestChapter != nil {
+		c := NewChapter(*md.NewestChapter)
+		detail.NewestChapter = &c
+	}
+
+	return detail
+}
+
+func parseNumber(value string) *float64 {
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+func parseYear(value string) *int {
+	y, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || y <= 0 {
+		return nil
+	}
+	return &y
+}
+
+func parseTime(value string) *time.Time {
+	t, err := common.ParseOriginTime(value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// LastModified is only known when the response holds the whole manga list.
+// It is zero for genre filtered responses.
+func (r MangaResponse) LastModified() time.Time {
+	if !r.Complete {
+		return time.Time{}
+	}
+
+	ts := []*time.Time{}
+	for _, m := range r.PopularMangas {
+		ts = append(ts, m.ModifiedAt)
+	}
+	for _, m := range r.LatestMangas {
+		ts = append(ts, m.ModifiedAt)
+	}
+	return getLatestTime(ts...)
+}
+
+func (r MangaDetailResponse) LastModified() time.Time {
+	ts := []*time.Time{r.Manga.ModifiedAt}
+	if r.Manga.NewestChapter != nil {
+		ts = append(ts, r.Manga.NewestChapter.ModifiedAt)
+	}
+	return getLatestTime(ts...)
+}
+
+func getLatestTime(ts ...*time.Time) time.Time {
+	var latest time.Time
+	for _, t := range ts {
+		if t != nil && t.After(latest) {
+			latest = *t
+		}
+	}
+	return latest
+}
@@ -0,0 +1,69 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MangaContractTestSuite struct {
+	suite.Suite
+}
+
+func TestMangaContractTestSuite(t *testing.T) {
+	suite.Run(t, new(MangaContractTestSuite))
+}
+
+func (s *MangaContractTestSuite) TestNewManga_ReturnsTypedManga() {
+	m := NewManga(contract.Manga{
+		Title:        "Bleach",
+		TitleID:      "bleach",
+		LastChapter:  "686.5",
+		Genre:        "Action, Supernatural",
+		Status:       "OnGoing",
+		PublishYear:  "2001",
+		ModifiedDate: "2019-04-12 13:05:59",
+	})
+
+	assert.Equal(s.T(), "Bleach", m.Title)
+	assert.Equal(s.T(), 686.5, *m.LastChapter)
+	assert.Equal(s.T(), []string{"Action", "Supernatural"}, m.Genres)
+	assert.Equal(s.T(), domain.MangaStatusOngoing, m.Status)
+	assert.Equal(s.T(), 2001, *m.PublishYear)
+	assert.Equal(s.T(), time.Date(2019, 4, 12, 6, 5, 59, 0, time.UTC), *m.ModifiedAt)
+}
+
+func (s *MangaContractTestSuite) TestNewManga_ReturnsNilFields_WhenValuesAreUnparseable() {
+	m := NewManga(contract.Manga{
+		LastChapter:  "-",
+		PublishYear:  "unknown",
+		ModifiedDate: "0000-00-00 00:00:00",
+	})
+
+	assert.Nil(s.T(), m.LastChapter)
+	assert.Nil(s.T(), m.PublishYear)
+	assert.Nil(s.T(), m.ModifiedAt)
+	assert.Equal(s.T(), domain.MangaStatusUnknown, m.Status)
+}
+
+func (s *MangaContractTestSuite) TestNewMangaDetail_ReturnsTypedMangaDetail() {
+	md := NewMangaDetail(contract.MangaDetail{
+		Manga:        contract.Manga{TitleID: "bleach", ModifiedDate: "2019-04-13 07:00:00"},
+		ChapterCount: 2,
+		NewestChapter: &contract.Chapter{
+			Number:       "2.5",
+			TitleID:      "bleach",
+			ModifiedDate: "2019-04-12 07:00:00",
+		},
+	})
+
+	assert.Equal(s.T(), "bleach", md.TitleID)
+	assert.Equal(s.T(), 2, md.ChapterCount)
+	assert.Equal(s.T(), 2.5, md.NewestChapter.Number)
+	assert.Equal(s.T(), time.Date(2019, 4, 12, 0, 0, 0, 0, time.UTC), *md.NewestChapter.ModifiedAt)
+	assert.Equal(s.T(), time.Date(2019, 4, 13, 0, 0, 0, 0, time.UTC), *md.ModifiedAt)
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ChapterID identifies a chapter of a title, like "657", "1001.55" or "10a".
+// It keeps the exact decimal digits given by the origin instead of going
+// through floats, and is always stored in its canonical form.
+type ChapterID string
+
+var chapterIDRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+))?([a-z]*)$`)
+
+// ParseChapterID reads a chapter number with an optional decimal part and
+// letter suffix. Leading zeros, trailing decimal zeros and letter case are
+// normalised, so "0657.500" and "657.5" give the same ID.
+func ParseChapterID(s string) (ChapterID, error) {
+	m := chapterIDRegex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(s)))
+	if m == nil {
+		return "", fmt.Errorf("invalid chapter: %q", s)
+	}
+
+	whole := strings.TrimLeft(m[1], "0")
+	if whole == "" {
+		whole = "0"
+	}
+
+	id := whole
+	if fraction := strings.TrimRight(m[2], "0"); fraction != "" {
+		id += "." + fraction
+	}
+	return ChapterID(id + m[3]), nil
+}
+
+func (c ChapterID) String() string {
+	return string(c)
+}
+
+func (c ChapterID) IsZero() bool {
+	return c == ""
+}
+
+// Compare orders chapters by number first, then by suffix, so "10" < "10a" <
+// "10.5" < "11". It returns -1, 0 or 1.
+func (c ChapterID) Compare(other ChapterID) int {
+	cw, cf, cs := c.split()
+	ow, of, os := other.split()
+
+	if len(cw) != len(ow) {
+		return compareInt(len(cw), len(ow))
+	}
+	if r := strings.Compare(cw, ow); r != 0 {
+		return r
+	}
+	if r := strings.Compare(cf, of); r != 0 {
+		return r
+	}
+	return strings.Compare(cs, os)
+}
+
+// Float64 returns the numeric part of the chapter, dropping any suffix.
+func (c ChapterID) Float64() float64 {
+	w, f, _ := c.split()
+	if f != "" {
+		w += "." + f
+	}
+	v, _ := strconv.ParseFloat(w, 64)
+	return v
+}
+
+func (c ChapterID) split() (whole, fraction, suffix string) {
+	s := string(c)
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= 'a' && r <= 'z' })
+	if i >= 0 {
+		s, suffix = s[:i], s[i:]
+	}
+	if j := strings.IndexByte(s, '.'); j >= 0 {
+		s, fraction = s[:j], s[j+1:]
+	}
+	return s, fraction, suffix
+}
+
+// UnmarshalJSON accepts both the JSON numbers sent by the origin and strings,
+// reading the number literal as is so no precision is lost.
+func (c *ChapterID) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*c = ""
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+
+	id, err := ParseChapterID(s)
+	if err != nil {
+		return err
+	}
+	*c = id
+	return nil
+}
+
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
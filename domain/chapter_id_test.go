@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChapterIDTestSuite struct {
+	suite.Suite
+}
+
+func TestChapterIDTestSuite(t *testing.T) {
+	suite.Run(t, new(ChapterIDTestSuite))
+}
+
+func (s *ChapterIDTestSuite) TestParseChapterID_ReturnsCanonicalID() {
+	for input, expected := range map[string]ChapterID{
+		"100":        "100",
+		"100.0":      "100",
+		"100.1":      "100.1",
+		"657.000000": "657",
+		"1001.55":    "1001.55",
+		"0010.500":   "10.5",
+		"0":          "0",
+		" 10A ":      "10a",
+		"10.5b":      "10.5b",
+	} {
+		id, err := ParseChapterID(input)
+
+		assert.Nil(s.T(), err, input)
+		assert.Equal(s.T(), expected, id, input)
+	}
+}
+
+func (s *ChapterIDTestSuite) TestParseChapterID_ReturnsError_WhenChapterIsMalformed() {
+	for _, input := range []string{"", "abc", "1.", ".5", "-1", "1e3", "1.2.3", "10a1", "1 0"} {
+		id, err := ParseChapterID(input)
+
+		assert.NotNil(s.T(), err, input)
+		assert.True(s.T(), id.IsZero(), input)
+	}
+}
+
+func (s *ChapterIDTestSuite) TestCompare_OrdersByNumberThenSuffix() {
+	ids := []ChapterID{"11", "10.5", "9", "10a", "100", "10", "10.05", "10.5a"}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) < 0
+	})
+
+	assert.Equal(s.T(), []ChapterID{"9", "10", "10a", "10.05", "10.5", "10.5a", "11", "100"}, ids)
+	assert.Equal(s.T(), 0, ChapterID("10.5").Compare("10.5"))
+}
+
+func (s *ChapterIDTestSuite) TestFloat64_DropsSuffix() {
+	assert.Equal(s.T(), 1001.55, ChapterID("1001.55").Float64())
+	assert.Equal(s.T(), float64(10), ChapterID("10a").Float64())
+}
+
+func (s *ChapterIDTestSuite) TestUnmarshalJSON_AcceptsNumbersAndStrings() {
+	var c struct {
+		A ChapterID `json:"a"`
+		B ChapterID `json:"b"`
+		C ChapterID `json:"c"`
+	}
+	err := json.Unmarshal([]byte(`{"a":1001.55,"b":"10A","c":null}`), &c)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), ChapterID("1001.55"), c.A)
+	assert.Equal(s.T(), ChapterID("10a"), c.B)
+	assert.True(s.T(), c.C.IsZero())
+}
+
+func (s *ChapterIDTestSuite) TestUnmarshalJSON_ReturnsError_WhenChapterIsMalformed() {
+	var c ChapterID
+	err := json.Unmarshal([]byte(`"foo"`), &c)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *ChapterIDTestSuite) TestMarshalJSON_WritesCanonicalString() {
+	b, err := json.Marshal(Chapter{Number: "657.5"})
+
+	assert.Nil(s.T(), err)
+	assert.Contains(s.T(), string(b), `"hidden_chapter":"657.5"`)
+}
@@ -8,3 +8,16 @@ type Content struct {
 type ContentListResponse struct {
 	Contents []Content `json:"chapter"`
 }
+
+// ImageMeta is what the reader needs to lay out a page before loading it.
+type ImageMeta struct {
+	Width  int   `json:"width"`
+	Height int   `json:"height"`
+	Size   int64 `json:"size"`
+}
+
+// ContentMetaResponse holds the probed page images of a chapter, keyed by
+// their origin URL.
+type ContentMetaResponse struct {
+	Images map[string]ImageMeta `json:"images"`
+}
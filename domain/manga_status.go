@@ -0,0 +1,24 @@
+package domain
+
+import "strings"
+
+// MangaStatus is the publication status of a title, read from the free-form
+// status given by the origin.
+type MangaStatus string
+
+const (
+	MangaStatusOngoing   MangaStatus = "ongoing"
+	MangaStatusCompleted MangaStatus = "completed"
+	MangaStatusUnknown   MangaStatus = "unknown"
+)
+
+func NewMangaStatus(status string) MangaStatus {
+	switch strings.ToLower(strings.Join(strings.Fields(status), "")) {
+	case "ongoing":
+		return MangaStatusOngoing
+	case "completed", "complete", "tamat", "end", "ended", "finished":
+		return MangaStatusCompleted
+	default:
+		return MangaStatusUnknown
+	}
+}
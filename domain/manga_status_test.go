@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type MangaStatusTestSuite struct {
+	suite.Suite
+}
+
+func TestMangaStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(MangaStatusTestSuite))
+}
+
+func (s *MangaStatusTestSuite) TestNewMangaStatus_ReturnsMappedStatus() {
+	assert.Equal(s.T(), MangaStatusOngoing, NewMangaStatus("OnGoing"))
+	assert.Equal(s.T(), MangaStatusOngoing, NewMangaStatus("On Going"))
+	assert.Equal(s.T(), MangaStatusCompleted, NewMangaStatus("Tamat"))
+	assert.Equal(s.T(), MangaStatusCompleted, NewMangaStatus("Completed"))
+	assert.Equal(s.T(), MangaStatusUnknown, NewMangaStatus(""))
+	assert.Equal(s.T(), MangaStatusUnknown, NewMangaStatus("hiatus"))
+}
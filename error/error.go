@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"reflect"
 	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
 )
 
 type GenericError struct {
@@ -59,11 +61,84 @@ func NewValidationError(validationErrors map[string]string) *ValidationError {
 	return &ValidationError{validationErrors: validationErrors}
 }
 
+type OriginUnavailableError struct {
+	S string
+}
+
+func (e *OriginUnavailableError) Error() string {
+	return fmt.Sprintf("%s %s", constants.ServerError, e.S)
+}
+
+func NewOriginUnavailableError(s string) *OriginUnavailableError {
+	return &OriginUnavailableError{S: s}
+}
+
+type OriginStatusError struct {
+	StatusCode int
+}
+
+func (e *OriginStatusError) Error() string {
+	return fmt.Sprintf("%s unexpected status code: %d", constants.ServerError, e.StatusCode)
+}
+
+func NewOriginStatusError(statusCode int) *OriginStatusError {
+	return &OriginStatusError{StatusCode: statusCode}
+}
+
+type InvalidOriginResponseError struct{}
+
+func (e *InvalidOriginResponseError) Error() string {
+	return constants.InvalidJSONResponseError
+}
+
+func NewInvalidOriginResponseError() *InvalidOriginResponseError {
+	return &InvalidOriginResponseError{}
+}
+
+type OriginResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *OriginResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%s response body exceeds %d bytes", constants.ServerError, e.Limit)
+}
+
+func NewOriginResponseTooLargeError(limit int64) *OriginResponseTooLargeError {
+	return &OriginResponseTooLargeError{Limit: limit}
+}
+
+// RateLimitedError is returned when an origin call is over its shared budget.
+// It is not an origin error, so callers do not fall back to other origin calls.
+type RateLimitedError struct {
+	Command string
+}
+
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("origin rate limit exceeded for %s", e.Command)
+}
+
+func NewRateLimitedError(command string) *RateLimitedError {
+	return &RateLimitedError{Command: command}
+}
+
+// IsOriginError reports whether err was caused by the origin server rather
+// than by this service.
+func IsOriginError(err error) bool {
+	return isErrorInstanceOf(err, (*OriginUnavailableError)(nil)) ||
+		isErrorInstanceOf(err, (*OriginStatusError)(nil)) ||
+		isErrorInstanceOf(err, (*InvalidOriginResponseError)(nil)) ||
+		isErrorInstanceOf(err, (*OriginResponseTooLargeError)(nil))
+}
+
 func GetStatusCodeOf(objectPtr interface{}) int {
 	if isErrorInstanceOf(objectPtr, (*NotFoundError)(nil)) {
 		return http.StatusNotFound
 	} else if isErrorInstanceOf(objectPtr, (*ValidationError)(nil)) {
 		return http.StatusBadRequest
+	} else if isErrorInstanceOf(objectPtr, (*RateLimitedError)(nil)) {
+		return http.StatusServiceUnavailable
+	} else if err, ok := objectPtr.(error); ok && IsOriginError(err) {
+		return http.StatusBadGateway
 	}
 	return http.StatusInternalServerError
 }
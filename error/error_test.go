@@ -44,6 +44,37 @@ func (s *ErrorTestSuite) TestError_ReturnsValidationError() {
 	assert.Contains(s.T(), err.Error(), "bar error")
 }
 
+func (s *ErrorTestSuite) TestError_ReturnsOriginErrors() {
+	assert.Equal(s.T(), "origin server error: timeout", NewOriginUnavailableError("timeout").Error())
+	assert.Equal(s.T(), "origin server error: unexpected status code: 404", NewOriginStatusError(404).Error())
+	assert.Equal(s.T(), "invalid JSON response from origin server", NewInvalidOriginResponseError().Error())
+	assert.Equal(s.T(), "origin server error: response body exceeds 10 bytes", NewOriginResponseTooLargeError(10).Error())
+	assert.Equal(s.T(), "origin rate limit exceeded for foo", NewRateLimitedError("foo").Error())
+}
+
+func (s *ErrorTestSuite) TestIsOriginError() {
+	assert.True(s.T(), IsOriginError(NewOriginUnavailableError("foo")))
+	assert.True(s.T(), IsOriginError(NewOriginStatusError(404)))
+	assert.True(s.T(), IsOriginError(NewInvalidOriginResponseError()))
+	assert.True(s.T(), IsOriginError(NewOriginResponseTooLargeError(10)))
+	assert.False(s.T(), IsOriginError(NewRateLimitedError("foo")))
+	assert.False(s.T(), IsOriginError(NewGenericError()))
+}
+
+func (s *ErrorTestSuite) TestGetStatusCodeOf_Returns502() {
+	err := NewOriginUnavailableError("foo")
+	code := GetStatusCodeOf(err)
+
+	assert.Equal(s.T(), http.StatusBadGateway, code)
+}
+
+func (s *ErrorTestSuite) TestGetStatusCodeOf_Returns503() {
+	err := NewRateLimitedError("foo")
+	code := GetStatusCodeOf(err)
+
+	assert.Equal(s.T(), http.StatusServiceUnavailable, code)
+}
+
 func (s *ErrorTestSuite) TestGetStatusCodeOf_Returns500() {
 	err := NewGenericError()
 	code := GetStatusCodeOf(err)
@@ -3,7 +3,8 @@ module github.com/bigscreen/mangindo-feeder
 go 1.14
 
 require (
-	github.com/afex/hystrix-go v0.0.0-20180502004556-fa1af6a1f4f5 // indirect
+	github.com/afex/hystrix-go v0.0.0-20180502004556-fa1af6a1f4f5
+	github.com/andybalholm/brotli v1.0.4
 	github.com/braintree/manners v0.0.0-20160418043613-82a8879fc5fd // indirect
 	github.com/certifi/gocertifi v0.0.0-20200922220541-2c3bb06c6054 // indirect
 	github.com/codegangsta/negroni v1.0.0
@@ -15,11 +16,14 @@ require (
 	github.com/gojektech/valkyrie v0.0.0-20190210220504-8f62c1e7ba45 // indirect
 	github.com/gomodule/redigo v2.0.0+incompatible
 	github.com/gorilla/mux v1.7.1
+	github.com/klauspost/compress v1.11.13
 	github.com/pkg/errors v0.8.1
 	github.com/robfig/cron v1.2.0 // indirect
 	github.com/sirupsen/logrus v1.6.0
 	github.com/spf13/viper v1.3.2
-	github.com/stretchr/testify v1.3.0
+	github.com/stretchr/testify v1.6.1
 	github.com/urfave/cli v1.20.0
+	github.com/vmihailenco/msgpack/v5 v5.3.5
+	golang.org/x/text v0.3.0
 	gopkg.in/h2non/gock.v1 v1.0.14
 )
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/service"
+	"github.com/bigscreen/mangindo-feeder/validator"
+	"github.com/gorilla/mux"
+)
+
+func GetBatchContents(s service.BatchContentService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		titleID := vars[constants.TitleIDKeyParam]
+
+		query := r.URL.Query()
+		chapters := query.Get(constants.ChaptersKeyParam)
+		from := query.Get(constants.FromKeyParam)
+		to := query.Get(constants.ToKeyParam)
+
+		validators := []validator.Validator{
+			validator.PresenceValidator{Field: constants.TitleIDKeyParam, Value: &titleID},
+		}
+		if chapters == "" && from == "" && to == "" {
+			validators = append(validators, validator.PresenceValidator{Field: constants.ChaptersKeyParam, Value: &chapters})
+		}
+		for _, c := range strings.Split(chapters, ",") {
+			if c := strings.TrimSpace(c); c != "" {
+				validators = append(validators, validator.ChapterValidator{Field: constants.ChaptersKeyParam, Value: &c})
+			}
+		}
+		for field, value := range map[string]*string{
+			constants.FromKeyParam: &from,
+			constants.ToKeyParam:   &to,
+		} {
+			if *value != "" {
+				validators = append(validators, validator.ChapterValidator{Field: field, Value: value})
+			}
+		}
+		isValid, errMsgs := validator.ValidateAll(validators)
+		if !isValid {
+			err := mErr.NewValidationError(errMsgs)
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		results, err := s.GetContents(r.Context(), contract.NewBatchContentRequest(titleID, chapters, from, to))
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		br := contract.BatchContentResponse{
+			Success: true,
+			TitleID: titleID,
+			Results: *results,
+		}
+		respondWith(http.StatusOK, r, w, br)
+	}
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchContentHandlerTestSuite struct {
+	suite.Suite
+	mr *mux.Router
+}
+
+func TestBatchContentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchContentHandlerTestSuite))
+}
+
+func buildBatchContentRequest(titleID, query string) (*http.Request, *httptest.ResponseRecorder) {
+	pVar := fmt.Sprintf("{%s}", constants.TitleIDKeyParam)
+	path := strings.Replace(constants.GetBatchContentsAPIPath, pVar, titleID, -1)
+	req, _ := http.NewRequest("GET", path+"?"+query, nil)
+	rr := httptest.NewRecorder()
+	return req, rr
+}
+
+func (s *BatchContentHandlerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *BatchContentHandlerTestSuite) SetupTest() {
+	s.mr = mux.NewRouter()
+}
+
+func (s *BatchContentHandlerTestSuite) TestGetBatchContents_ReturnsError_WhenChaptersAreBlank() {
+	bcs := &mMock.BatchContentServiceMock{}
+
+	req, rr := buildBatchContentRequest("bleach", "")
+
+	s.mr.HandleFunc(constants.GetBatchContentsAPIPath, GetBatchContents(bcs))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "chapters cannot be blank")
+	bcs.AssertNotCalled(s.T(), "GetContents", mock.Anything)
+}
+
+func (s *BatchContentHandlerTestSuite) TestGetBatchContents_ReturnsError_WhenChapterIsNotNumber() {
+	bcs := &mMock.BatchContentServiceMock{}
+
+	req, rr := buildBatchContentRequest("bleach", "chapters=1,foo")
+
+	s.mr.HandleFunc(constants.GetBatchContentsAPIPath, GetBatchContents(bcs))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	bcs.AssertNotCalled(s.T(), "GetContents", mock.Anything)
+}
+
+func (s *BatchContentHandlerTestSuite) TestGetBatchContents_ReturnsError_WhenServiceReturnsError() {
+	err := mErr.NewNotFoundError("chapter")
+	bcs := &mMock.BatchContentServiceMock{}
+	bcs.On("GetContents", mock.Anything, contract.NewBatchContentRequest("bleach", "", "1", "5")).Return(nil, err)
+
+	req, rr := buildBatchContentRequest("bleach", "from=1&to=5")
+
+	s.mr.HandleFunc(constants.GetBatchContentsAPIPath, GetBatchContents(bcs))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusNotFound, rr.Code)
+}
+
+func (s *BatchContentHandlerTestSuite) TestGetBatchContents_ReturnsSuccess() {
+	results := []contract.BatchContent{
+		{Chapter: "1", Success: true, Contents: []contract.Content{{ImageURL: "http://foo.com/1.jpg", Page: 1}}, TotalPages: 1},
+		{Chapter: "2", Contents: []contract.Content{}, Error: "Could not find content"},
+	}
+	bcs := &mMock.BatchContentServiceMock{}
+	bcs.On("GetContents", mock.Anything, contract.NewBatchContentRequest("bleach", "1,2", "", "")).Return(&results, nil)
+
+	req, rr := buildBatchContentRequest("bleach", "chapters=1,2")
+
+	s.mr.HandleFunc(constants.GetBatchContentsAPIPath, GetBatchContents(bcs))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(contract.BatchContentResponse{Success: true, TitleID: "bleach", Results: results})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+}
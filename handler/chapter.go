@@ -11,14 +11,50 @@ import (
 	"github.com/gorilla/mux"
 )
 
+type chapterListPresenter func(cr contract.ChapterResponse) interface{}
+
 func GetChapters(s service.ChapterService) http.HandlerFunc {
+	return getChapters(s, func(cr contract.ChapterResponse) interface{} { return cr })
+}
+
+func getChapters(s service.ChapterService, present chapterListPresenter) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		titleID := vars[constants.TitleIDKeyParam]
 
+		query := r.URL.Query()
+		page := query.Get(constants.PageKeyParam)
+		limit := query.Get(constants.LimitKeyParam)
+		order := query.Get(constants.OrderKeyParam)
+		from := query.Get(constants.FromKeyParam)
+		to := query.Get(constants.ToKeyParam)
+
 		validators := []validator.Validator{
 			validator.PresenceValidator{Field: constants.TitleIDKeyParam, Value: &titleID},
 		}
+		for field, value := range map[string]*string{
+			constants.PageKeyParam:  &page,
+			constants.LimitKeyParam: &limit,
+		} {
+			if *value != "" {
+				validators = append(validators, validator.NumberValidator{Field: field, Value: value, Integer: true})
+			}
+		}
+		for field, value := range map[string]*string{
+			constants.FromKeyParam: &from,
+			constants.ToKeyParam:   &to,
+		} {
+			if *value != "" {
+				validators = append(validators, validator.ChapterValidator{Field: field, Value: value})
+			}
+		}
+		if order != "" {
+			validators = append(validators, validator.InclusionValidator{
+				Field:   constants.OrderKeyParam,
+				Value:   &order,
+				Options: []string{constants.ChapterOrderAsc, constants.ChapterOrderDesc},
+			})
+		}
 		isValid, errMsgs := validator.ValidateAll(validators)
 		if !isValid {
 			err := mErr.NewValidationError(errMsgs)
@@ -26,16 +62,13 @@ func GetChapters(s service.ChapterService) http.HandlerFunc {
 			return
 		}
 
-		chapters, err := s.GetChapters(contract.NewChapterRequest(titleID))
+		req := contract.NewPagedChapterRequest(titleID, page, limit, order, from, to)
+		chapters, total, err := s.GetChapters(r.Context(), req)
 		if err != nil {
 			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
 			return
 		}
 
-		cr := contract.ChapterResponse{
-			Success:  true,
-			Chapters: *chapters,
-		}
-		respondWith(http.StatusOK, r, w, cr)
+		respondWith(http.StatusOK, r, w, present(contract.NewChapterResponse(req, *chapters, total)))
 	}
 }
@@ -67,7 +67,7 @@ func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenQueryParamIsB
 func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenUnknownErrorHappens() {
 	err := mErr.NewGenericError()
 	cs := &mMock.ChapterServiceMock{}
-	cs.On("GetChapters", contract.NewChapterRequest("foo")).Return(nil, err)
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(nil, 0, err)
 
 	req, rr := buildChapterRequest("foo")
 
@@ -81,10 +81,44 @@ func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenUnknownErrorH
 	cs.AssertExpectations(s.T())
 }
 
+func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsBadGateway_WhenOriginFails() {
+	err := mErr.NewOriginStatusError(http.StatusInternalServerError)
+	cs := &mMock.ChapterServiceMock{}
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(nil, 0, err)
+
+	req, rr := buildChapterRequest("foo")
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusBadGateway, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	cs.AssertExpectations(s.T())
+}
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsServiceUnavailable_WhenOriginCallIsRejected() {
+	err := mErr.NewRateLimitedError("GetChapterList")
+	cs := &mMock.ChapterServiceMock{}
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(nil, 0, err)
+
+	req, rr := buildChapterRequest("foo")
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusServiceUnavailable, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	cs.AssertExpectations(s.T())
+}
+
 func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenChaptersDoNotExist() {
 	err := mErr.NewNotFoundError("chapter")
 	cs := &mMock.ChapterServiceMock{}
-	cs.On("GetChapters", contract.NewChapterRequest("foo")).Return(nil, err)
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(nil, 0, err)
 
 	req, rr := buildChapterRequest("foo")
 
@@ -106,7 +140,7 @@ func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsSuccess_WhenChaptersExi
 	}
 	ccs := []contract.Chapter{cc}
 	cs := &mMock.ChapterServiceMock{}
-	cs.On("GetChapters", contract.NewChapterRequest("foo")).Return(&ccs, nil)
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(&ccs, 1, nil)
 
 	req, rr := buildChapterRequest("foo")
 
@@ -116,6 +150,9 @@ func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsSuccess_WhenChaptersExi
 	cr := contract.ChapterResponse{
 		Success:  true,
 		Chapters: ccs,
+		Total:    1,
+		Page:     1,
+		Limit:    1,
 	}
 	res, _ := json.Marshal(cr)
 
@@ -123,3 +160,98 @@ func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsSuccess_WhenChaptersExi
 	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
 	cs.AssertExpectations(s.T())
 }
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_SetsLastModified_WhenAllChaptersAreReturned() {
+	ccs := []contract.Chapter{{Number: "54", Title: "Foo", TitleID: "foo", ModifiedDate: "2019-04-12 13:05:59"}}
+	cs := &mMock.ChapterServiceMock{}
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("foo")).Return(&ccs, 1, nil)
+
+	req, rr := buildChapterRequest("foo")
+	req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+	assert.Equal(s.T(), "Fri, 12 Apr 2019 06:05:59 GMT", rr.Header().Get("Last-Modified"))
+	cs.AssertExpectations(s.T())
+}
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_SkipsLastModified_WhenChaptersArePagedOrRanged() {
+	for query, cReq := range map[string]contract.ChapterRequest{
+		"limit=1": contract.NewPagedChapterRequest("foo", "", "1", "", "", ""),
+		"from=10": contract.NewPagedChapterRequest("foo", "", "", "", "10", ""),
+		"to=60":   contract.NewPagedChapterRequest("foo", "", "", "", "", "60"),
+	} {
+		ccs := []contract.Chapter{{Number: "54", Title: "Foo", TitleID: "foo", ModifiedDate: "2019-04-12 13:05:59"}}
+		cs := &mMock.ChapterServiceMock{}
+		cs.On("GetChapters", mock.Anything, cReq).Return(&ccs, 3, nil)
+
+		req, rr := buildChapterRequest("foo")
+		req.URL.RawQuery = query
+		req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+
+		mr := mux.NewRouter()
+		mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+		mr.ServeHTTP(rr, req)
+
+		assert.Equal(s.T(), http.StatusOK, rr.Code, query)
+		assert.NotEmpty(s.T(), rr.Header().Get("ETag"), query)
+		assert.Empty(s.T(), rr.Header().Get("Last-Modified"), query)
+		cs.AssertExpectations(s.T())
+	}
+}
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenQueryParamsAreInvalid() {
+	cs := &mMock.ChapterServiceMock{}
+
+	req, rr := buildChapterRequest("foo")
+	req.URL.RawQuery = "page=abc&order=up"
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	body := rr.Body.String()
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), body, "page must be a whole number")
+	assert.Contains(s.T(), body, "order must be one of asc, desc")
+	cs.AssertNotCalled(s.T(), "GetChapters", mock.Anything)
+}
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsError_WhenPaginationIsNotAWholeNumber() {
+	cs := &mMock.ChapterServiceMock{}
+
+	req, rr := buildChapterRequest("foo")
+	req.URL.RawQuery = "page=abc5&limit=1.5"
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	body := rr.Body.String()
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), body, "page must be a whole number")
+	assert.Contains(s.T(), body, "limit must be a whole number")
+	cs.AssertNotCalled(s.T(), "GetChapters", mock.Anything)
+}
+
+func (s *ChapterHandlerTestSuite) TestGetChapters_ReturnsPagedSuccess_WhenPaginationIsRequested() {
+	ccs := []contract.Chapter{{Number: "54", Title: "Foo", TitleID: "foo"}}
+	cReq := contract.NewPagedChapterRequest("foo", "1", "1", "asc", "", "")
+	cs := &mMock.ChapterServiceMock{}
+	cs.On("GetChapters", mock.Anything, cReq).Return(&ccs, 3, nil)
+
+	req, rr := buildChapterRequest("foo")
+	req.URL.RawQuery = "page=1&limit=1&order=asc"
+
+	s.mr.HandleFunc(constants.GetChaptersAPIPath, GetChapters(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(contract.NewChapterResponse(cReq, ccs, 3))
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	assert.Contains(s.T(), rr.Body.String(), `"next_page":2`)
+	cs.AssertExpectations(s.T())
+}
@@ -11,7 +11,13 @@ import (
 	"github.com/gorilla/mux"
 )
 
+type contentListPresenter func(cr contract.ContentResponse) interface{}
+
 func GetContents(s service.ContentService) http.HandlerFunc {
+	return getContents(s, func(cr contract.ContentResponse) interface{} { return cr })
+}
+
+func getContents(s service.ContentService, present contentListPresenter) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		titleID := vars[constants.TitleIDKeyParam]
@@ -20,7 +26,7 @@ func GetContents(s service.ContentService) http.HandlerFunc {
 		validators := []validator.Validator{
 			validator.PresenceValidator{Field: constants.TitleIDKeyParam, Value: &titleID},
 			validator.PresenceValidator{Field: constants.ChapterKeyParam, Value: &chapter},
-			validator.NumberValidator{Field: constants.ChapterKeyParam, Value: &chapter},
+			validator.ChapterValidator{Field: constants.ChapterKeyParam, Value: &chapter},
 		}
 		isValid, errMsgs := validator.ValidateAll(validators)
 		if !isValid {
@@ -29,16 +35,22 @@ func GetContents(s service.ContentService) http.HandlerFunc {
 			return
 		}
 
-		contents, err := s.GetContents(contract.NewContentRequest(titleID, chapter))
+		req := contract.NewContentRequest(titleID, chapter)
+		contents, err := s.GetContents(r.Context(), req)
 		if err != nil {
 			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
 			return
 		}
 
+		prev, next := s.GetNavigation(r.Context(), req)
+
 		cr := contract.ContentResponse{
-			Success:  true,
-			Contents: *contents,
+			Success:         true,
+			Contents:        *contents,
+			TotalPages:      len(*contents),
+			PreviousChapter: prev,
+			NextChapter:     next,
 		}
-		respondWith(http.StatusOK, r, w, cr)
+		respondWith(http.StatusOK, r, w, present(cr))
 	}
 }
@@ -78,14 +78,14 @@ func (s *ContentHandlerTestSuite) TestGetContents_ReturnsError_WhenInvalidChapte
 	s.mr.ServeHTTP(rr, req)
 
 	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
-	assert.Contains(s.T(), rr.Body.String(), "chapter must be a number")
+	assert.Contains(s.T(), rr.Body.String(), "chapter must be a chapter number")
 	cs.AssertNotCalled(s.T(), "GetContents", req.Context(), mock.Anything)
 }
 
 func (s *ContentHandlerTestSuite) TestGetContents_ReturnsError_WhenUnknownErrorHappens() {
 	err := mErr.NewGenericError()
 	cs := &mMock.ContentServiceMock{}
-	cs.On("GetContents", contract.NewContentRequest("foo", "123")).Return(nil, err)
+	cs.On("GetContents", mock.Anything, contract.NewContentRequest("foo", "123")).Return(nil, err)
 
 	req, rr := buildContentRequest("foo", "123")
 
@@ -99,10 +99,27 @@ func (s *ContentHandlerTestSuite) TestGetContents_ReturnsError_WhenUnknownErrorH
 	cs.AssertExpectations(s.T())
 }
 
+func (s *ContentHandlerTestSuite) TestGetContents_ReturnsBadGateway_WhenOriginFails() {
+	err := mErr.NewInvalidOriginResponseError()
+	cs := &mMock.ContentServiceMock{}
+	cs.On("GetContents", mock.Anything, contract.NewContentRequest("foo", "123")).Return(nil, err)
+
+	req, rr := buildContentRequest("foo", "123")
+
+	s.mr.HandleFunc(constants.GetContentsAPIPath, GetContents(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusBadGateway, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	cs.AssertExpectations(s.T())
+}
+
 func (s *ContentHandlerTestSuite) TestGetContents_ReturnsError_WhenContentsDoNotExist() {
 	err := mErr.NewNotFoundError("chapter")
 	cs := &mMock.ContentServiceMock{}
-	cs.On("GetContents", contract.NewContentRequest("foo", "123")).Return(nil, err)
+	cs.On("GetContents", mock.Anything, contract.NewContentRequest("foo", "123")).Return(nil, err)
 
 	req, rr := buildContentRequest("foo", "123")
 
@@ -119,14 +136,19 @@ func (s *ContentHandlerTestSuite) TestGetContents_ReturnsError_WhenContentsDoNot
 func (s *ContentHandlerTestSuite) TestGetContents_ReturnsSuccess_WhenContentsExist() {
 	cc := contract.Content{
 		ImageURL: "http://foo.com/foo.jpg",
+		Page:     2,
 	}
 	ccs := []contract.Content{cc}
+	prev := &contract.Chapter{Number: "122.5", Title: "Foo 122.5", TitleID: "foo"}
 	cr := contract.ContentResponse{
-		Success:  true,
-		Contents: ccs,
+		Success:         true,
+		Contents:        ccs,
+		TotalPages:      1,
+		PreviousChapter: prev,
 	}
 	cs := &mMock.ContentServiceMock{}
-	cs.On("GetContents", contract.NewContentRequest("foo", "123")).Return(&ccs, nil)
+	cs.On("GetContents", mock.Anything, contract.NewContentRequest("foo", "123")).Return(&ccs, nil)
+	cs.On("GetNavigation", mock.Anything, contract.NewContentRequest("foo", "123")).Return(prev, nil)
 
 	req, rr := buildContentRequest("foo", "123")
 
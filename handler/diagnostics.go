@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/service"
+)
+
+func GetSchemaDiagnostics(s service.DiagnosticsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reports, err := s.GetSchemaReports(r.Context())
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		dr := contract.SchemaDiagnosticsResponse{
+			Success: true,
+			Schemas: reports,
+		}
+		respondWith(http.StatusOK, r, w, dr)
+	}
+}
+
+func GetRateLimitDiagnostics(s service.DiagnosticsService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		reports, err := s.GetRateLimitReports(r.Context())
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		dr := contract.RateLimitDiagnosticsResponse{
+			Success:    true,
+			RateLimits: reports,
+		}
+		respondWith(http.StatusOK, r, w, dr)
+	}
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiagnosticsHandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestDiagnosticsHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(DiagnosticsHandlerTestSuite))
+}
+
+func (s *DiagnosticsHandlerTestSuite) TestGetSchemaDiagnostics_ReturnsReports() {
+	reports := []contract.SchemaReport{
+		{
+			Endpoint:         "foo",
+			HasDrift:         true,
+			UnknownFields:    map[string]int{"komik[].jenis": 1},
+			MissingFields:    map[string]int{},
+			MismatchedFields: map[string]string{},
+			CheckedAt:        time.Date(2019, 4, 12, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	ds := &mMock.DiagnosticsServiceMock{}
+	ds.On("GetSchemaReports", mock.Anything).Return(reports, nil)
+
+	req, _ := http.NewRequest("GET", constants.SchemaDiagnosticsAPIPath, nil)
+	rr := httptest.NewRecorder()
+
+	GetSchemaDiagnostics(ds)(rr, req)
+
+	res, _ := json.Marshal(contract.SchemaDiagnosticsResponse{Success: true, Schemas: reports})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+}
+
+func (s *DiagnosticsHandlerTestSuite) TestGetRateLimitDiagnostics_ReturnsReports() {
+	reports := []contract.RateLimitReport{{Endpoint: "foo", Throttled: 3, Rejected: 1, TotalWaitMs: 1500}}
+	ds := &mMock.DiagnosticsServiceMock{}
+	ds.On("GetRateLimitReports", mock.Anything).Return(reports, nil)
+
+	req, _ := http.NewRequest("GET", constants.RateLimitDiagnosticsAPIPath, nil)
+	rr := httptest.NewRecorder()
+
+	GetRateLimitDiagnostics(ds)(rr, req)
+
+	res, _ := json.Marshal(contract.RateLimitDiagnosticsResponse{Success: true, RateLimits: reports})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+}
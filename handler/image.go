@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/service"
+	"github.com/bigscreen/mangindo-feeder/validator"
+	"github.com/gorilla/mux"
+)
+
+// GetImage streams an origin image through the proxy. Cached images go
+// through http.ServeContent, which handles content type sniffing, conditional
+// requests and byte ranges. Images fetched from the origin are streamed whole
+// as they are cached, with their type sniffed by the response writer.
+func GetImage(s service.ImageService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		imagePath := strings.TrimPrefix(path.Clean("/"+mux.Vars(r)[constants.ImagePathKeyParam]), "/")
+
+		validators := []validator.Validator{
+			validator.PresenceValidator{Field: constants.ImagePathKeyParam, Value: &imagePath},
+		}
+		isValid, errMsgs := validator.ValidateAll(validators)
+		if !isValid {
+			err := mErr.NewValidationError(errMsgs)
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		image, err := s.GetImage(r.Context(), imagePath)
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+		if c, ok := image.Content.(io.Closer); ok {
+			defer c.Close()
+		}
+
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(image.MaxAge.Seconds())))
+		if rs, ok := image.Content.(io.ReadSeeker); ok {
+			http.ServeContent(w, r, image.Name, image.ModifiedAt, rs)
+			return
+		}
+
+		w.Header().Set("Last-Modified", image.ModifiedAt.UTC().Format(http.TimeFormat))
+		if _, err := io.Copy(w, image.Content); err != nil {
+			logger.Errorf("Failed to stream image %s, with error: %s", imagePath, err.Error())
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageHandlerTestSuite struct {
+	suite.Suite
+	mr *mux.Router
+}
+
+func TestImageHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageHandlerTestSuite))
+}
+
+func buildImageRequest(path string) (*http.Request, *httptest.ResponseRecorder) {
+	req, _ := http.NewRequest("GET", constants.ImageProxyAPIPath+"/"+path, nil)
+	rr := httptest.NewRecorder()
+	return req, rr
+}
+
+func (s *ImageHandlerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *ImageHandlerTestSuite) SetupTest() {
+	s.mr = mux.NewRouter()
+}
+
+func getFakeImage() *contract.Image {
+	return &contract.Image{
+		Name:       "01.png",
+		ModifiedAt: time.Date(2016, 8, 18, 18, 59, 58, 0, time.UTC),
+		Content:    bytes.NewReader([]byte("\x89PNG\r\n\x1a\n0123456789")),
+		MaxAge:     time.Hour,
+	}
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_ReturnsImage() {
+	is := &mMock.ImageServiceMock{}
+	is.On("GetImage", mock.Anything, "mangas/bleach/686/01.png").Return(getFakeImage(), nil)
+
+	req, rr := buildImageRequest("mangas/bleach/686/01.png")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "image/png", rr.Header().Get("Content-Type"))
+	assert.Equal(s.T(), "public, max-age=3600", rr.Header().Get("Cache-Control"))
+	assert.Equal(s.T(), "Thu, 18 Aug 2016 18:59:58 GMT", rr.Header().Get("Last-Modified"))
+	assert.Equal(s.T(), "18", rr.Header().Get("Content-Length"))
+	is.AssertExpectations(s.T())
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_ReturnsPartialContent_WhenRangeIsRequested() {
+	is := &mMock.ImageServiceMock{}
+	is.On("GetImage", mock.Anything, "mangas/bleach/686/01.png").Return(getFakeImage(), nil)
+
+	req, rr := buildImageRequest("mangas/bleach/686/01.png")
+	req.Header.Set("Range", "bytes=8-11")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusPartialContent, rr.Code)
+	assert.Equal(s.T(), "bytes 8-11/18", rr.Header().Get("Content-Range"))
+	assert.Equal(s.T(), "0123", rr.Body.String())
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_StreamsWholeImage_WhenImageIsNotCached() {
+	image := getFakeImage()
+	image.Content = ioutil.NopCloser(strings.NewReader("\x89PNG\r\n\x1a\n0123456789"))
+	is := &mMock.ImageServiceMock{}
+	is.On("GetImage", mock.Anything, "mangas/bleach/686/01.png").Return(image, nil)
+
+	req, rr := buildImageRequest("mangas/bleach/686/01.png")
+	req.Header.Set("Range", "bytes=8-11")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "image/png", rr.Header().Get("Content-Type"))
+	assert.Equal(s.T(), "public, max-age=3600", rr.Header().Get("Cache-Control"))
+	assert.Equal(s.T(), "Thu, 18 Aug 2016 18:59:58 GMT", rr.Header().Get("Last-Modified"))
+	assert.Empty(s.T(), rr.Header().Get("Content-Range"))
+	assert.Equal(s.T(), "\x89PNG\r\n\x1a\n0123456789", rr.Body.String())
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_ReturnsNotModified_WhenImageIsUnchanged() {
+	is := &mMock.ImageServiceMock{}
+	is.On("GetImage", mock.Anything, "mangas/bleach/686/01.png").Return(getFakeImage(), nil)
+
+	req, rr := buildImageRequest("mangas/bleach/686/01.png")
+	req.Header.Set("If-Modified-Since", "Thu, 18 Aug 2016 18:59:58 GMT")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_ReturnsError_WhenImageDoesNotExist() {
+	err := mErr.NewNotFoundError("image")
+	is := &mMock.ImageServiceMock{}
+	is.On("GetImage", mock.Anything, "mangas/bleach/686/99.png").Return(nil, err)
+
+	req, rr := buildImageRequest("mangas/bleach/686/99.png")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusNotFound, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	is.AssertExpectations(s.T())
+}
+
+func (s *ImageHandlerTestSuite) TestGetImage_ReturnsError_WhenPathIsBlank() {
+	is := &mMock.ImageServiceMock{}
+
+	req, rr := buildImageRequest("%20")
+
+	s.mr.HandleFunc(constants.GetImageAPIPath, GetImage(is))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "path cannot be blank")
+	is.AssertNotCalled(s.T(), "GetImage", mock.Anything, mock.Anything)
+}
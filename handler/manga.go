@@ -3,14 +3,49 @@ package handler
 import (
 	"net/http"
 
+	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/contract"
 	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/service"
+	"github.com/bigscreen/mangindo-feeder/validator"
+	"github.com/gorilla/mux"
 )
 
+type mangaListPresenter func(mr contract.MangaResponse) interface{}
+
+type mangaPresenter func(mr contract.MangaDetailResponse) interface{}
+
 func GetMangas(s service.MangaService) http.HandlerFunc {
+	return getMangas(s, func(mr contract.MangaResponse) interface{} { return mr })
+}
+
+func GetManga(s service.MangaService) http.HandlerFunc {
+	return getManga(s, func(mr contract.MangaDetailResponse) interface{} { return mr })
+}
+
+func getMangas(s service.MangaService, present mangaListPresenter) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		pop, lts, err := s.GetMangas()
+		query := r.URL.Query()
+		genreMatch := query.Get(constants.GenreMatchParam)
+
+		if genreMatch != "" {
+			validators := []validator.Validator{
+				validator.InclusionValidator{
+					Field:   constants.GenreMatchParam,
+					Value:   &genreMatch,
+					Options: []string{constants.GenreMatchAll, constants.GenreMatchAny},
+				},
+			}
+			isValid, errMsgs := validator.ValidateAll(validators)
+			if !isValid {
+				err := mErr.NewValidationError(errMsgs)
+				respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+				return
+			}
+		}
+
+		req := contract.NewMangaListRequest(query[constants.GenreKeyParam], genreMatch)
+		pop, lts, err := s.GetMangas(r.Context(), req)
 		if err != nil {
 			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
 			return
@@ -34,8 +69,53 @@ func GetMangas(s service.MangaService) http.HandlerFunc {
 			Success:       true,
 			PopularMangas: pms,
 			LatestMangas:  lms,
+			Complete:      len(req.Genres) == 0,
+		}
+		respondWith(http.StatusOK, r, w, present(mr))
+	}
+}
+
+func getManga(s service.MangaService, present mangaPresenter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		titleID := vars[constants.TitleIDKeyParam]
+
+		validators := []validator.Validator{
+			validator.PresenceValidator{Field: constants.TitleIDKeyParam, Value: &titleID},
+		}
+		isValid, errMsgs := validator.ValidateAll(validators)
+		if !isValid {
+			err := mErr.NewValidationError(errMsgs)
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
 		}
-		respondWith(http.StatusOK, r, w, mr)
 
+		manga, err := s.GetManga(r.Context(), contract.NewMangaRequest(titleID))
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		mr := contract.MangaDetailResponse{
+			Success: true,
+			Manga:   *manga,
+		}
+		respondWith(http.StatusOK, r, w, present(mr))
+	}
+}
+
+func GetGenres(s service.MangaService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		genres, err := s.GetGenres(r.Context())
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		gr := contract.GenreResponse{
+			Success: true,
+			Genres:  *genres,
+		}
+		respondWith(http.StatusOK, r, w, gr)
 	}
 }
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -14,7 +15,9 @@ import (
 	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -39,7 +42,7 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsError_WhenUnknownErrorHappe
 	err := mErr.NewGenericError()
 
 	ms := &mMock.MangaServiceMock{}
-	ms.On("GetMangas").Return(nil, nil, err)
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(nil, nil, err)
 
 	h := GetMangas(ms)
 	h.ServeHTTP(rr, req)
@@ -51,6 +54,25 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsError_WhenUnknownErrorHappe
 	ms.AssertExpectations(s.T())
 }
 
+func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsBadGateway_WhenOriginFails() {
+	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath, nil)
+
+	rr := httptest.NewRecorder()
+	err := mErr.NewOriginUnavailableError("timeout")
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(nil, nil, err)
+
+	h := GetMangas(ms)
+	h.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusBadGateway, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ms.AssertExpectations(s.T())
+}
+
 func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsError_WhenMangasDoNotExist() {
 	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath, nil)
 
@@ -58,7 +80,7 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsError_WhenMangasDoNotExist(
 	err := mErr.NewNotFoundError("manga")
 
 	ms := &mMock.MangaServiceMock{}
-	ms.On("GetMangas").Return(nil, nil, err)
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(nil, nil, err)
 
 	h := GetMangas(ms)
 	h.ServeHTTP(rr, req)
@@ -82,7 +104,7 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsSuccess_WhenOnlyPopularMang
 	}
 
 	ms := &mMock.MangaServiceMock{}
-	ms.On("GetMangas").Return(&pms, nil, nil)
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(&pms, nil, nil)
 
 	h := GetMangas(ms)
 	h.ServeHTTP(rr, req)
@@ -106,7 +128,7 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsSuccess_WhenOnlyLatestManga
 	}
 
 	ms := &mMock.MangaServiceMock{}
-	ms.On("GetMangas").Return(nil, &lms, nil)
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(nil, &lms, nil)
 
 	h := GetMangas(ms)
 	h.ServeHTTP(rr, req)
@@ -131,7 +153,7 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsSuccess_WhenPopularAndLates
 	}
 
 	ms := &mMock.MangaServiceMock{}
-	ms.On("GetMangas").Return(&pms, &lms, nil)
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(&pms, &lms, nil)
 
 	h := GetMangas(ms)
 	h.ServeHTTP(rr, req)
@@ -143,6 +165,185 @@ func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsSuccess_WhenPopularAndLates
 	ms.AssertExpectations(s.T())
 }
 
+func (s *MangaHandlerTestSuite) TestGetMangas_ReturnsError_WhenGenreMatchIsInvalid() {
+	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath+"?genre=action&genre_match=some", nil)
+
+	rr := httptest.NewRecorder()
+	ms := &mMock.MangaServiceMock{}
+
+	h := GetMangas(ms)
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "genre_match must be one of all, any")
+	ms.AssertNotCalled(s.T(), "GetMangas", mock.Anything)
+}
+
+func (s *MangaHandlerTestSuite) TestGetMangas_PassesGenreFilter_WhenGenresAreRequested() {
+	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath+"?genre=action&genre=comedy&genre_match=all", nil)
+
+	rr := httptest.NewRecorder()
+	lms := []contract.Manga{getFakeLatestManga()}
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest([]string{"action", "comedy"}, "all")).Return(nil, &lms, nil)
+
+	h := GetMangas(ms)
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	ms.AssertExpectations(s.T())
+}
+
+func (s *MangaHandlerTestSuite) TestGetMangas_SetsLastModified_WhenListIsNotFiltered() {
+	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath, nil)
+	req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+
+	rr := httptest.NewRecorder()
+	lm := getFakeLatestManga()
+	lm.ModifiedDate = "2019-04-12 13:05:59"
+	lms := []contract.Manga{lm}
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(nil, &lms, nil)
+
+	h := GetMangas(ms)
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+	assert.Equal(s.T(), "Fri, 12 Apr 2019 06:05:59 GMT", rr.Header().Get("Last-Modified"))
+	ms.AssertExpectations(s.T())
+}
+
+func (s *MangaHandlerTestSuite) TestGetMangas_SkipsLastModified_WhenListIsFilteredByGenre() {
+	req, _ := http.NewRequest("GET", constants.GetMangasAPIPath+"?genre=action", nil)
+	req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+
+	rr := httptest.NewRecorder()
+	lm := getFakeLatestManga()
+	lm.ModifiedDate = "2019-04-12 13:05:59"
+	lms := []contract.Manga{lm}
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest([]string{"action"}, "")).Return(nil, &lms, nil)
+
+	h := GetMangas(ms)
+	h.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.NotEmpty(s.T(), rr.Header().Get("ETag"))
+	assert.Empty(s.T(), rr.Header().Get("Last-Modified"))
+	ms.AssertExpectations(s.T())
+}
+
+func (s *MangaHandlerTestSuite) TestGetGenres_ReturnsError_WhenGenresDoNotExist() {
+	req, _ := http.NewRequest("GET", constants.GetGenresAPIPath, nil)
+
+	rr := httptest.NewRecorder()
+	err := mErr.NewNotFoundError("genre")
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetGenres", mock.Anything).Return(nil, err)
+
+	h := GetGenres(ms)
+	h.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusNotFound, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ms.AssertExpectations(s.T())
+}
+
+func (s *MangaHandlerTestSuite) TestGetGenres_ReturnsSuccess_WhenGenresExist() {
+	req, _ := http.NewRequest("GET", constants.GetGenresAPIPath, nil)
+
+	rr := httptest.NewRecorder()
+	genres := []contract.Genre{{Name: "Action", MangaCount: 2}}
+
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetGenres", mock.Anything).Return(&genres, nil)
+
+	h := GetGenres(ms)
+	h.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(contract.GenreResponse{
+		Success: true,
+		Genres:  genres,
+	})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ms.AssertExpectations(s.T())
+}
+
+func buildMangaRequest(titleID string) (*http.Request, *httptest.ResponseRecorder) {
+	pVar := fmt.Sprintf("{%s}", constants.TitleIDKeyParam)
+	path := strings.Replace(constants.GetMangaAPIPath, pVar, titleID, -1)
+	req, _ := http.NewRequest("GET", path, nil)
+	rr := httptest.NewRecorder()
+	return req, rr
+}
+
+func (s *MangaHandlerTestSuite) TestGetManga_ReturnsError_WhenTitleIDIsBlank() {
+	ms := &mMock.MangaServiceMock{}
+
+	req, rr := buildMangaRequest(" ")
+
+	mr := mux.NewRouter()
+	mr.HandleFunc(constants.GetMangaAPIPath, GetManga(ms))
+	mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "title_id cannot be blank")
+	ms.AssertNotCalled(s.T(), "GetManga", mock.Anything)
+}
+
+func (s *MangaHandlerTestSuite) TestGetManga_ReturnsError_WhenMangaDoesNotExist() {
+	err := mErr.NewNotFoundError("manga")
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetManga", mock.Anything, contract.NewMangaRequest("foo")).Return(nil, err)
+
+	req, rr := buildMangaRequest("foo")
+
+	mr := mux.NewRouter()
+	mr.HandleFunc(constants.GetMangaAPIPath, GetManga(ms))
+	mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusNotFound, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ms.AssertExpectations(s.T())
+}
+
+func (s *MangaHandlerTestSuite) TestGetManga_ReturnsSuccess_WhenMangaExists() {
+	md := contract.MangaDetail{
+		Manga:         getFakePopularManga(),
+		ChapterCount:  1,
+		NewestChapter: &contract.Chapter{Number: "939", Title: "One Piece 939", TitleID: "one_piece"},
+	}
+	md.ModifiedDate = "2019-04-12 13:05:59"
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetManga", mock.Anything, contract.NewMangaRequest("one_piece")).Return(&md, nil)
+
+	req, rr := buildMangaRequest("one_piece")
+
+	mr := mux.NewRouter()
+	mr.HandleFunc(constants.GetMangaAPIPath, GetManga(ms))
+	mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(contract.MangaDetailResponse{
+		Success: true,
+		Manga:   md,
+	})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	assert.Contains(s.T(), rr.Body.String(), `"modified_date":"2019-04-12 13:05:59"`)
+	ms.AssertExpectations(s.T())
+}
+
 func getFakePopularManga() contract.Manga {
 	return contract.Manga{
 		Title:       "One Piece",
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/service"
+	"github.com/bigscreen/mangindo-feeder/validator"
+)
+
+type searchPresenter func(sr contract.SearchResponse) interface{}
+
+func Search(s service.SearchService) http.HandlerFunc {
+	return search(s, func(sr contract.SearchResponse) interface{} { return sr })
+}
+
+func search(s service.SearchService, present searchPresenter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query().Get(constants.QueryKeyParam)
+
+		validators := []validator.Validator{
+			validator.PresenceValidator{Field: constants.QueryKeyParam, Value: &query},
+		}
+		isValid, errMsgs := validator.ValidateAll(validators)
+		if !isValid {
+			err := mErr.NewValidationError(errMsgs)
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		mangas, err := s.Search(r.Context(), contract.NewSearchRequest(query))
+		if err != nil {
+			respondWith(mErr.GetStatusCodeOf(err), r, w, getErrorResponse(err))
+			return
+		}
+
+		sr := contract.SearchResponse{
+			Success: true,
+			Mangas:  *mangas,
+		}
+		respondWith(http.StatusOK, r, w, present(sr))
+	}
+}
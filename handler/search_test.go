@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchHandlerTestSuite struct {
+	suite.Suite
+}
+
+func TestSearchHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchHandlerTestSuite))
+}
+
+func (s *SearchHandlerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func buildSearchRequest(query string) (*http.Request, *httptest.ResponseRecorder) {
+	req, _ := http.NewRequest("GET", constants.SearchAPIPath, nil)
+	req.URL.RawQuery = "q=" + query
+	rr := httptest.NewRecorder()
+	return req, rr
+}
+
+func (s *SearchHandlerTestSuite) TestSearch_ReturnsError_WhenQueryIsBlank() {
+	ss := &mMock.SearchServiceMock{}
+
+	req, rr := buildSearchRequest("")
+	Search(ss).ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), "q cannot be blank")
+	ss.AssertNotCalled(s.T(), "Search", mock.Anything)
+}
+
+func (s *SearchHandlerTestSuite) TestSearch_ReturnsError_WhenUnknownErrorHappens() {
+	err := mErr.NewGenericError()
+	ss := &mMock.SearchServiceMock{}
+	ss.On("Search", mock.Anything, contract.NewSearchRequest("piece")).Return(nil, err)
+
+	req, rr := buildSearchRequest("piece")
+	Search(ss).ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(getErrorResponse(err))
+
+	assert.Equal(s.T(), http.StatusInternalServerError, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ss.AssertExpectations(s.T())
+}
+
+func (s *SearchHandlerTestSuite) TestSearch_ReturnsSuccess_WhenMangasMatch() {
+	ms := []contract.Manga{getFakePopularManga()}
+	ss := &mMock.SearchServiceMock{}
+	ss.On("Search", mock.Anything, contract.NewSearchRequest("piece")).Return(&ms, nil)
+
+	req, rr := buildSearchRequest("piece")
+	Search(ss).ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(contract.SearchResponse{
+		Success: true,
+		Mangas:  ms,
+	})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	ss.AssertExpectations(s.T())
+}
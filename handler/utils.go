@@ -1,19 +1,79 @@
 package handler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/logger"
 )
 
+// lastModifier is implemented by responses built from dated origin
+// resources. Lists only return a date when they hold the whole collection,
+// as the newest date in a page or filter says nothing about the rest of it,
+// so those are only revalidated with their ETag.
+type lastModifier interface {
+	LastModified() time.Time
+}
+
 func respondWith(statusCode int, r *http.Request, w http.ResponseWriter, response interface{}) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	if response != nil {
-		_ = json.NewEncoder(w).Encode(response)
+	if response == nil {
+		w.WriteHeader(statusCode)
 		return
 	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		logger.Errorf("Failed to encode response, error: %s", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	body = append(body, '\n')
+
+	if statusCode == http.StatusOK {
+		setCacheAgeHeaders(r, w)
+	}
+
+	if statusCode == http.StatusOK && isConditionalMethod(r.Method) {
+		etag := getETag(body)
+		w.Header().Set("ETag", etag)
+
+		var lastModified time.Time
+		if lm, ok := response.(lastModifier); ok {
+			lastModified = lm.LastModified()
+		}
+		if !lastModified.IsZero() {
+			w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+
+		if isNotModified(r, etag, lastModified) {
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
+
+// setCacheAgeHeaders tells clients how old the cached data of a response is,
+// and warns them when it is served stale while being refreshed.
+func setCacheAgeHeaders(r *http.Request, w http.ResponseWriter) {
+	age, stale := common.GetCacheAge(r.Context())
+	if age > 0 {
+		w.Header().Set("Age", strconv.Itoa(int(age.Seconds())))
+	}
+	if stale {
+		w.Header().Set("Warning", `110 - "Response is Stale"`)
+	}
 }
 
 func getErrorResponse(err error) contract.ErrorResponse {
@@ -22,3 +82,34 @@ func getErrorResponse(err error) contract.ErrorResponse {
 		Error:   err.Error(),
 	}
 }
+
+func isConditionalMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+func getETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ims := r.Header.Get("If-Modified-Since")
+	if ims == "" || lastModified.IsZero() {
+		return false
+	}
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(t)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/common"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type UtilsTestSuite struct {
+	suite.Suite
+}
+
+func TestUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(UtilsTestSuite))
+}
+
+func buildChapterResponse() contract.ChapterResponse {
+	return contract.ChapterResponse{
+		Success: true,
+		Chapters: []contract.Chapter{
+			{Number: "2", TitleID: "bleach", ModifiedDate: "2019-04-12 13:05:59"},
+			{Number: "1", TitleID: "bleach", ModifiedDate: "2019-04-10 13:05:59"},
+		},
+		Total: 2,
+	}
+}
+
+func buildMangaDetailResponse() contract.MangaDetailResponse {
+	return contract.MangaDetailResponse{
+		Success: true,
+		Manga: contract.MangaDetail{
+			Manga:         contract.Manga{TitleID: "bleach", ModifiedDate: "2019-04-10 13:05:59"},
+			NewestChapter: &contract.Chapter{Number: "2", TitleID: "bleach", ModifiedDate: "2019-04-12 13:05:59"},
+		},
+	}
+}
+
+func (s *UtilsTestSuite) TestRespondWith_SetsETagAndLastModified() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req, rr, buildMangaDetailResponse())
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.NotEmpty(s.T(), rr.Header().Get("ETag"))
+	assert.Equal(s.T(), "Fri, 12 Apr 2019 06:05:59 GMT", rr.Header().Get("Last-Modified"))
+	assert.NotEmpty(s.T(), rr.Body.String())
+}
+
+func (s *UtilsTestSuite) TestRespondWith_ReturnsNotModified_WhenETagMatches() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	rr := httptest.NewRecorder()
+	respondWith(http.StatusOK, req, rr, buildChapterResponse())
+
+	req.Header.Set("If-None-Match", `"foo", `+rr.Header().Get("ETag"))
+	rr = httptest.NewRecorder()
+	respondWith(http.StatusOK, req, rr, buildChapterResponse())
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+	assert.Empty(s.T(), rr.Body.String())
+}
+
+func (s *UtilsTestSuite) TestRespondWith_ReturnsOK_WhenETagDoesNotMatch() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	req.Header.Set("If-None-Match", `"foo"`)
+	req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req, rr, buildMangaDetailResponse())
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+}
+
+func (s *UtilsTestSuite) TestRespondWith_ReturnsNotModified_WhenNotModifiedSince() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	req.Header.Set("If-Modified-Since", "Fri, 12 Apr 2019 06:05:59 GMT")
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req, rr, buildMangaDetailResponse())
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+}
+
+func (s *UtilsTestSuite) TestRespondWith_ReturnsOK_WhenModifiedSince() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	req.Header.Set("If-Modified-Since", "Thu, 11 Apr 2019 00:00:00 GMT")
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req, rr, buildMangaDetailResponse())
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+}
+
+func (s *UtilsTestSuite) TestRespondWith_SkipsLastModified_WhenResponseIsPaginated() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	req.Header.Set("If-Modified-Since", "Sat, 13 Apr 2019 00:00:00 GMT")
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req, rr, buildChapterResponse())
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.NotEmpty(s.T(), rr.Header().Get("ETag"))
+	assert.Empty(s.T(), rr.Header().Get("Last-Modified"))
+}
+
+func (s *UtilsTestSuite) TestRespondWith_SkipsConditionals_WhenStatusIsNotOK() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	req.Header.Set("If-None-Match", "*")
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusBadRequest, req, rr, contract.ErrorResponse{Error: "foo"})
+
+	assert.Equal(s.T(), http.StatusBadRequest, rr.Code)
+	assert.Empty(s.T(), rr.Header().Get("ETag"))
+}
+
+func (s *UtilsTestSuite) TestRespondWith_SetsAgeAndWarning_WhenCacheIsStale() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	ctx := common.WithCacheAge(req.Context())
+	common.RecordCacheAge(ctx, 90*time.Minute, true)
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req.WithContext(ctx), rr, buildChapterResponse())
+
+	assert.Equal(s.T(), "5400", rr.Header().Get("Age"))
+	assert.Equal(s.T(), `110 - "Response is Stale"`, rr.Header().Get("Warning"))
+}
+
+func (s *UtilsTestSuite) TestRespondWith_SkipsWarning_WhenCacheIsFresh() {
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	ctx := common.WithCacheAge(req.Context())
+	common.RecordCacheAge(ctx, time.Minute, false)
+	rr := httptest.NewRecorder()
+
+	respondWith(http.StatusOK, req.WithContext(ctx), rr, buildChapterResponse())
+
+	assert.Equal(s.T(), "60", rr.Header().Get("Age"))
+	assert.Empty(s.T(), rr.Header().Get("Warning"))
+}
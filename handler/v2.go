@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/bigscreen/mangindo-feeder/contract"
+	v2 "github.com/bigscreen/mangindo-feeder/contract/v2"
+	"github.com/bigscreen/mangindo-feeder/service"
+)
+
+func GetMangasV2(s service.MangaService) http.HandlerFunc {
+	return getMangas(s, func(mr contract.MangaResponse) interface{} {
+		return v2.MangaResponse{
+			Success:       mr.Success,
+			PopularMangas: v2.NewMangas(mr.PopularMangas),
+			LatestMangas:  v2.NewMangas(mr.LatestMangas),
+			Complete:      mr.Complete,
+		}
+	})
+}
+
+func GetMangaV2(s service.MangaService) http.HandlerFunc {
+	return getManga(s, func(mr contract.MangaDetailResponse) interface{} {
+		return v2.MangaDetailResponse{
+			Success: mr.Success,
+			Manga:   v2.NewMangaDetail(mr.Manga),
+		}
+	})
+}
+
+func GetChaptersV2(s service.ChapterService) http.HandlerFunc {
+	return getChapters(s, func(cr contract.ChapterResponse) interface{} {
+		return v2.NewChapterResponse(cr)
+	})
+}
+
+func GetContentsV2(s service.ContentService) http.HandlerFunc {
+	return getContents(s, func(cr contract.ContentResponse) interface{} {
+		return v2.NewContentResponse(cr)
+	})
+}
+
+func SearchV2(s service.SearchService) http.HandlerFunc {
+	return search(s, func(sr contract.SearchResponse) interface{} {
+		return v2.SearchResponse{
+			Success: sr.Success,
+			Mangas:  v2.NewMangas(sr.Mangas),
+		}
+	})
+}
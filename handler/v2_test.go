@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	v2 "github.com/bigscreen/mangindo-feeder/contract/v2"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	mMock "github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type V2HandlerTestSuite struct {
+	suite.Suite
+	mr *mux.Router
+}
+
+func TestV2HandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(V2HandlerTestSuite))
+}
+
+func (s *V2HandlerTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *V2HandlerTestSuite) SetupTest() {
+	s.mr = mux.NewRouter()
+}
+
+func (s *V2HandlerTestSuite) TestGetMangasV2_ReturnsTypedMangas() {
+	pms := []contract.Manga{{TitleID: "bleach", LastChapter: "686", Status: "OnGoing", PublishYear: "2001"}}
+	ms := &mMock.MangaServiceMock{}
+	ms.On("GetMangas", mock.Anything, contract.NewMangaListRequest(nil, "")).Return(&pms, &[]contract.Manga{}, nil)
+
+	req, _ := http.NewRequest("GET", constants.GetMangasV2APIPath, nil)
+	rr := httptest.NewRecorder()
+
+	s.mr.HandleFunc(constants.GetMangasV2APIPath, GetMangasV2(ms))
+	s.mr.ServeHTTP(rr, req)
+
+	res, _ := json.Marshal(v2.MangaResponse{
+		Success:       true,
+		PopularMangas: v2.NewMangas(pms),
+		LatestMangas:  []v2.Manga{},
+	})
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), string(res), strings.TrimSuffix(rr.Body.String(), "\n"))
+	assert.Contains(s.T(), rr.Body.String(), `"last_chapter":686`)
+	assert.Contains(s.T(), rr.Body.String(), `"status":"ongoing"`)
+	assert.Contains(s.T(), rr.Body.String(), `"publish_year":2001`)
+}
+
+func (s *V2HandlerTestSuite) TestGetChaptersV2_ReturnsNumericChapterNumbers() {
+	chapters := []contract.Chapter{{Number: "10.5", TitleID: "bleach", ModifiedDate: "2019-04-12 13:05:59"}}
+	cs := &mMock.ChapterServiceMock{}
+	cs.On("GetChapters", mock.Anything, contract.NewChapterRequest("bleach")).Return(&chapters, 1, nil)
+
+	path := strings.Replace(constants.GetChaptersV2APIPath, "{title_id}", "bleach", -1)
+	req, _ := http.NewRequest("GET", path, nil)
+	rr := httptest.NewRecorder()
+
+	s.mr.HandleFunc(constants.GetChaptersV2APIPath, GetChaptersV2(cs))
+	s.mr.ServeHTTP(rr, req)
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Contains(s.T(), rr.Body.String(), `"id":"10.5","number":10.5`)
+	assert.Contains(s.T(), rr.Body.String(), `"modified_at":"2019-04-12T06:05:59Z"`)
+}
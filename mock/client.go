@@ -1,6 +1,9 @@
 package mock
 
 import (
+	"context"
+	"io"
+
 	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/stretchr/testify/mock"
 )
@@ -9,8 +12,8 @@ type MangaClientMock struct {
 	mock.Mock
 }
 
-func (m *MangaClientMock) GetMangaList() (*domain.MangaListResponse, error) {
-	args := m.Called()
+func (m *MangaClientMock) GetMangaList(ctx context.Context) (*domain.MangaListResponse, error) {
+	args := m.Called(ctx)
 	if args.Get(1) != nil {
 		return nil, args.Get(1).(error)
 	}
@@ -21,8 +24,8 @@ type ChapterClientMock struct {
 	mock.Mock
 }
 
-func (m *ChapterClientMock) GetChapterList(titleID string) (*domain.ChapterListResponse, error) {
-	args := m.Called(titleID)
+func (m *ChapterClientMock) GetChapterList(ctx context.Context, titleID string) (*domain.ChapterListResponse, error) {
+	args := m.Called(ctx, titleID)
 	if args.Get(1) != nil {
 		return nil, args.Get(1).(error)
 	}
@@ -33,10 +36,30 @@ type ContentClientMock struct {
 	mock.Mock
 }
 
-func (m *ContentClientMock) GetContentList(titleID string, chapter float32) (*domain.ContentListResponse, error) {
-	args := m.Called(titleID, chapter)
+func (m *ContentClientMock) GetContentList(ctx context.Context, titleID string, chapter domain.ChapterID) (*domain.ContentListResponse, error) {
+	args := m.Called(ctx, titleID, chapter)
 	if args.Get(1) != nil {
 		return nil, args.Get(1).(error)
 	}
 	return args.Get(0).(*domain.ContentListResponse), nil
 }
+
+type ImageClientMock struct {
+	mock.Mock
+}
+
+func (m *ImageClientMock) GetImage(ctx context.Context, host, path string) (io.ReadCloser, error) {
+	args := m.Called(ctx, host, path)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(io.ReadCloser), nil
+}
+
+func (m *ImageClientMock) GetImageMeta(ctx context.Context, imageURL string) (*domain.ImageMeta, error) {
+	args := m.Called(ctx, imageURL)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(*domain.ImageMeta), nil
+}
@@ -1,7 +1,10 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -9,8 +12,8 @@ type MangaServiceMock struct {
 	mock.Mock
 }
 
-func (m *MangaServiceMock) GetMangas() (popular *[]contract.Manga, latest *[]contract.Manga, err error) {
-	args := m.Called()
+func (m *MangaServiceMock) GetMangas(ctx context.Context, req contract.MangaListRequest) (popular *[]contract.Manga, latest *[]contract.Manga, err error) {
+	args := m.Called(ctx, req)
 	if args.Get(2) != nil {
 		return nil, nil, args.Get(2).(error)
 	}
@@ -23,30 +26,101 @@ func (m *MangaServiceMock) GetMangas() (popular *[]contract.Manga, latest *[]con
 	return args.Get(0).(*[]contract.Manga), args.Get(1).(*[]contract.Manga), nil
 }
 
-type ChapterServiceMock struct {
-	mock.Mock
+func (m *MangaServiceMock) GetManga(ctx context.Context, req contract.MangaRequest) (*contract.MangaDetail, error) {
+	args := m.Called(ctx, req)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(*contract.MangaDetail), nil
 }
 
-func (m *ChapterServiceMock) GetChapters(req contract.ChapterRequest) (*[]contract.Chapter, error) {
-	args := m.Called(req)
+func (m *MangaServiceMock) GetGenres(ctx context.Context) (*[]contract.Genre, error) {
+	args := m.Called(ctx)
 	if args.Get(1) != nil {
 		return nil, args.Get(1).(error)
 	}
-	return args.Get(0).(*[]contract.Chapter), nil
+	return args.Get(0).(*[]contract.Genre), nil
+}
+
+type ChapterServiceMock struct {
+	mock.Mock
+}
+
+func (m *ChapterServiceMock) GetChapters(ctx context.Context, req contract.ChapterRequest) (chapters *[]contract.Chapter, total int, err error) {
+	args := m.Called(ctx, req)
+	if args.Get(2) != nil {
+		return nil, 0, args.Get(2).(error)
+	}
+	return args.Get(0).(*[]contract.Chapter), args.Int(1), nil
 }
 
 type ContentServiceMock struct {
 	mock.Mock
 }
 
-func (m *ContentServiceMock) GetContents(req contract.ContentRequest) (*[]contract.Content, error) {
-	args := m.Called(req)
+func (m *ContentServiceMock) GetContents(ctx context.Context, req contract.ContentRequest) (*[]contract.Content, error) {
+	args := m.Called(ctx, req)
 	if args.Get(1) != nil {
 		return nil, args.Get(1).(error)
 	}
 	return args.Get(0).(*[]contract.Content), nil
 }
 
+func (m *ContentServiceMock) GetNavigation(ctx context.Context, req contract.ContentRequest) (previous *contract.Chapter, next *contract.Chapter) {
+	args := m.Called(ctx, req)
+	if args.Get(0) != nil {
+		previous = args.Get(0).(*contract.Chapter)
+	}
+	if args.Get(1) != nil {
+		next = args.Get(1).(*contract.Chapter)
+	}
+	return previous, next
+}
+
+type SearchServiceMock struct {
+	mock.Mock
+}
+
+func (m *SearchServiceMock) Search(ctx context.Context, req contract.SearchRequest) (*[]contract.Manga, error) {
+	args := m.Called(ctx, req)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(*[]contract.Manga), nil
+}
+
+type BatchContentServiceMock struct {
+	mock.Mock
+}
+
+func (m *BatchContentServiceMock) GetContents(ctx context.Context, req contract.BatchContentRequest) (*[]contract.BatchContent, error) {
+	args := m.Called(ctx, req)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(*[]contract.BatchContent), nil
+}
+
+type DiagnosticsServiceMock struct {
+	mock.Mock
+}
+
+func (m *DiagnosticsServiceMock) GetSchemaReports(ctx context.Context) ([]contract.SchemaReport, error) {
+	args := m.Called(ctx)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).([]contract.SchemaReport), nil
+}
+
+func (m *DiagnosticsServiceMock) GetRateLimitReports(ctx context.Context) ([]contract.RateLimitReport, error) {
+	args := m.Called(ctx)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).([]contract.RateLimitReport), nil
+}
+
 type WorkerServiceMock struct {
 	mock.Mock
 }
@@ -67,10 +141,38 @@ func (m *WorkerServiceMock) SetChapterCache(titleID string) error {
 	return nil
 }
 
-func (m *WorkerServiceMock) SetContentCache(titleID string, chapter float32) error {
+func (m *WorkerServiceMock) SetContentCache(titleID string, chapter domain.ChapterID) error {
+	args := m.Called(titleID, chapter)
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+	return nil
+}
+
+func (m *WorkerServiceMock) SetContentMeta(titleID string, chapter domain.ChapterID) error {
 	args := m.Called(titleID, chapter)
 	if args.Get(0) != nil {
 		return args.Get(0).(error)
 	}
 	return nil
 }
+
+func (m *WorkerServiceMock) SetSearchIndex() error {
+	args := m.Called()
+	if args.Get(0) != nil {
+		return args.Get(0).(error)
+	}
+	return nil
+}
+
+type ImageServiceMock struct {
+	mock.Mock
+}
+
+func (m *ImageServiceMock) GetImage(ctx context.Context, path string) (*contract.Image, error) {
+	args := m.Called(ctx, path)
+	if args.Get(1) != nil {
+		return nil, args.Get(1).(error)
+	}
+	return args.Get(0).(*contract.Image), nil
+}
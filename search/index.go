@@ -0,0 +1,176 @@
+package search
+
+import (
+	"math"
+	"sort"
+
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+const (
+	titleWeight  = 4.0
+	aliasWeight  = 3.0
+	authorWeight = 2.0
+	genreWeight  = 1.0
+
+	exactMatchScore  = 1.0
+	prefixMatchScore = 0.8
+	typoMatchScore   = 0.5
+
+	minPrefixLength = 2
+)
+
+type Entry struct {
+	Manga  domain.Manga `json:"manga"`
+	Title  []string     `json:"title"`
+	Alias  []string     `json:"alias"`
+	Author []string     `json:"author"`
+	Genre  []string     `json:"genre"`
+}
+
+type Index struct {
+	Version string  `json:"version"`
+	Entries []Entry `json:"entries"`
+}
+
+type Result struct {
+	Manga domain.Manga
+	Score float64
+}
+
+func NewIndex(version string, mangas []domain.Manga) *Index {
+	entries := make([]Entry, 0, len(mangas))
+	for _, dm := range mangas {
+		entries = append(entries, Entry{
+			Manga:  dm,
+			Title:  Tokenize(dm.Title),
+			Alias:  Tokenize(dm.Alias),
+			Author: Tokenize(dm.Author),
+			Genre:  Tokenize(dm.Genre),
+		})
+	}
+
+	return &Index{
+		Version: version,
+		Entries: entries,
+	}
+}
+
+func (i *Index) Search(query string, limit int) []Result {
+	qTokens := Tokenize(query)
+	if len(qTokens) == 0 {
+		return []Result{}
+	}
+
+	results := []Result{}
+	for _, e := range i.Entries {
+		score := 0.0
+		for _, qt := range qTokens {
+			score += titleWeight * matchScore(qt, e.Title)
+			score += aliasWeight * matchScore(qt, e.Alias)
+			score += authorWeight * matchScore(qt, e.Author)
+			score += genreWeight * matchScore(qt, e.Genre)
+		}
+		if score > 0 {
+			results = append(results, Result{Manga: e.Manga, Score: score})
+		}
+	}
+
+	sort.SliceStable(results, func(a, b int) bool {
+		if results[a].Score == results[b].Score {
+			return results[a].Manga.Title < results[b].Manga.Title
+		}
+		return results[a].Score > results[b].Score
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func matchScore(qToken string, tokens []string) float64 {
+	best := 0.0
+	for _, t := range tokens {
+		switch {
+		case t == qToken:
+			return exactMatchScore
+		case len(qToken) >= minPrefixLength && len(t) > len(qToken) && t[:len(qToken)] == qToken:
+			best = math.Max(best, prefixMatchScore)
+		case isTypo(qToken, t):
+			best = math.Max(best, typoMatchScore)
+		}
+	}
+	return best
+}
+
+func isTypo(a, b string) bool {
+	allowed := maxTypos(len([]rune(a)))
+	if allowed == 0 {
+		return false
+	}
+	return levenshtein(a, b, allowed) <= allowed
+}
+
+func maxTypos(length int) int {
+	switch {
+	case length >= 8:
+		return 2
+	case length >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// levenshtein returns the edit distance between a and b, giving up with
+// bound+1 as soon as the distance is known to exceed bound.
+func levenshtein(a, b string, bound int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) > bound {
+		return bound + 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minOf(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > bound {
+			return bound + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minOf(first int, rest ...int) int {
+	m := first
+	for _, n := range rest {
+		if n < m {
+			m = n
+		}
+	}
+	return m
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type IndexTestSuite struct {
+	suite.Suite
+	idx *Index
+}
+
+func TestIndexTestSuite(t *testing.T) {
+	suite.Run(t, new(IndexTestSuite))
+}
+
+func (s *IndexTestSuite) SetupTest() {
+	s.idx = NewIndex("v1", []domain.Manga{
+		{TitleID: "one_piece", Title: "One Piece", Author: "Eiichiro Oda", Genre: "Action, Adventure, Comedy"},
+		{TitleID: "boku_no_hero_academia", Title: "Boku No Hero Academia", Alias: "My Hero Academia", Author: "Horikoshi Kouhei", Genre: "Action, School Life"},
+		{TitleID: "pokemon", Title: "Pokémon Adventures", Author: "Kusaka Hidenori", Genre: "Adventure, Fantasy"},
+	})
+}
+
+func (s *IndexTestSuite) TestNormalize_RemovesAccentsCaseAndPunctuation() {
+	assert.Equal(s.T(), "pokemon  adventures", Normalize("Pokémon, Adventures"))
+	assert.Equal(s.T(), []string{"pokemon", "adventures"}, Tokenize("Pokémon, Adventures"))
+}
+
+func (s *IndexTestSuite) TestSearch_ReturnsEmptyResult_WhenQueryIsBlank() {
+	assert.Empty(s.T(), s.idx.Search(" , ", 10))
+}
+
+func (s *IndexTestSuite) TestSearch_IgnoresAccentsAndCase() {
+	results := s.idx.Search("POKEMON", 10)
+
+	assert.Equal(s.T(), 1, len(results))
+	assert.Equal(s.T(), "pokemon", results[0].Manga.TitleID)
+}
+
+func (s *IndexTestSuite) TestSearch_ToleratesTypos() {
+	results := s.idx.Search("acadamia", 10)
+
+	assert.Equal(s.T(), 1, len(results))
+	assert.Equal(s.T(), "boku_no_hero_academia", results[0].Manga.TitleID)
+}
+
+func (s *IndexTestSuite) TestSearch_MatchesPrefixes() {
+	results := s.idx.Search("eiich", 10)
+
+	assert.Equal(s.T(), 1, len(results))
+	assert.Equal(s.T(), "one_piece", results[0].Manga.TitleID)
+}
+
+func (s *IndexTestSuite) TestSearch_RanksTitleMatchesAboveGenreMatches() {
+	results := s.idx.Search("adventure", 10)
+
+	assert.Equal(s.T(), 2, len(results))
+	assert.Equal(s.T(), "pokemon", results[0].Manga.TitleID)
+	assert.Equal(s.T(), "one_piece", results[1].Manga.TitleID)
+}
+
+func (s *IndexTestSuite) TestSearch_LimitsResults() {
+	results := s.idx.Search("action", 1)
+
+	assert.Equal(s.T(), 1, len(results))
+}
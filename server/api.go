@@ -7,7 +7,10 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/bigscreen/mangindo-feeder/service"
@@ -19,14 +22,25 @@ func StartAPIServer() {
 	logger.Info("Starting mangindo-feeder service")
 
 	deps := service.InstantiateDependencies()
+	go cache.SubscribeInvalidations(context.Background())
 	muxRouter := Router(deps)
 	handlerFunc := muxRouter.ServeHTTP
 
 	n := negroni.New(negroni.NewRecovery())
 	n.Use(negroniRecoverHandler())
+	n.Use(negroniCompressionHandler(config.Compression()))
+	n.Use(negroniTimeoutHandler(config.RequestTimeout()))
+	n.Use(negroniCacheAgeHandler())
 	n.UseHandlerFunc(handlerFunc)
 	portInfo := ":" + strconv.Itoa(config.Port())
-	server := &http.Server{Addr: portInfo, Handler: n}
+	// The write timeout is a backstop for handlers that ignore their context,
+	// and leaves the others time to write a response once it is done.
+	server := &http.Server{
+		Addr:         portInfo,
+		Handler:      n,
+		ReadTimeout:  config.RequestTimeout(),
+		WriteTimeout: 2 * config.RequestTimeout(),
+	}
 	go listenServer(server)
 	waitForShutdown(server)
 }
@@ -38,6 +52,22 @@ func negroniRecoverHandler() negroni.HandlerFunc {
 	})
 }
 
+// negroniTimeoutHandler sets the deadline of the request context, which every
+// origin call, retry and rate limit wait made for the request gives up at.
+func negroniTimeoutHandler(timeout time.Duration) negroni.HandlerFunc {
+	return negroni.HandlerFunc(func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next(w, r.WithContext(ctx))
+	})
+}
+
+func negroniCacheAgeHandler() negroni.HandlerFunc {
+	return negroni.HandlerFunc(func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		next(w, r.WithContext(common.WithCacheAge(r.Context())))
+	})
+}
+
 func listenServer(apiServer *http.Server) {
 	err := apiServer.ListenAndServe()
 	if err != http.ErrServerClosed {
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/codegangsta/negroni"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type APITestSuite struct {
+	suite.Suite
+}
+
+func TestAPITestSuite(t *testing.T) {
+	suite.Run(t, new(APITestSuite))
+}
+
+func (s *APITestSuite) TestTimeoutHandler_SetsRequestDeadline() {
+	var ctx context.Context
+	n := negroni.New()
+	n.Use(negroniTimeoutHandler(time.Minute))
+	n.UseHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})
+
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	start := time.Now()
+	n.ServeHTTP(httptest.NewRecorder(), req)
+
+	deadline, ok := ctx.Deadline()
+	assert.True(s.T(), ok)
+	assert.WithinDuration(s.T(), start.Add(time.Minute), deadline, time.Second)
+	assert.Equal(s.T(), context.Canceled, ctx.Err())
+}
+
+func (s *APITestSuite) TestTimeoutHandler_CancelsRequest_WhenTimeoutPasses() {
+	var err error
+	n := negroni.New()
+	n.Use(negroniTimeoutHandler(10 * time.Millisecond))
+	n.UseHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+		err = r.Context().Err()
+	})
+
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	n.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(s.T(), context.DeadlineExceeded, err)
+}
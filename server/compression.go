@@ -0,0 +1,225 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/codegangsta/negroni"
+)
+
+const (
+	gzipEncoding   = "gzip"
+	brotliEncoding = "br"
+)
+
+func negroniCompressionHandler(cfg config.CompressionConfig) negroni.HandlerFunc {
+	return negroni.HandlerFunc(func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead {
+			next(w, r)
+			return
+		}
+
+		if inm := r.Header.Get("If-None-Match"); inm != "" {
+			r.Header.Set("If-None-Match", stripETagEncoding(inm))
+		}
+
+		cw := &compressResponseWriter{ResponseWriter: w, encoding: encoding, config: cfg}
+		defer cw.close()
+		next(cw, r)
+	})
+}
+
+// negotiateEncoding picks the supported coding with the highest q-value,
+// preferring brotli over gzip on ties.
+func negotiateEncoding(acceptEncoding string) string {
+	qs := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name == "" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+					q = v
+				}
+			}
+		}
+		qs[name] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, enc := range []string{brotliEncoding, gzipEncoding} {
+		q, ok := qs[enc]
+		if !ok {
+			q, ok = qs["*"]
+		}
+		if ok && q > bestQ {
+			best, bestQ = enc, q
+		}
+	}
+	return best
+}
+
+func stripETagEncoding(inm string) string {
+	tags := strings.Split(inm, ",")
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		for _, enc := range []string{brotliEncoding, gzipEncoding} {
+			tag = strings.Replace(tag, "-"+enc+`"`, `"`, 1)
+		}
+		tags[i] = tag
+	}
+	return strings.Join(tags, ", ")
+}
+
+// compressResponseWriter buffers the body until it reaches the configured
+// minimum size, then switches to a compressing writer. Smaller bodies, bodies
+// that already carry a Content-Encoding, images, partial content and bodiless
+// statuses are passed through untouched.
+type compressResponseWriter struct {
+	http.ResponseWriter
+	encoding   string
+	config     config.CompressionConfig
+	statusCode int
+	buf        []byte
+	writer     io.WriteCloser
+	started    bool
+}
+
+func (cw *compressResponseWriter) WriteHeader(statusCode int) {
+	if cw.statusCode == 0 {
+		cw.statusCode = statusCode
+	}
+}
+
+func (cw *compressResponseWriter) Write(b []byte) (int, error) {
+	if cw.statusCode == 0 {
+		cw.statusCode = http.StatusOK
+	}
+
+	if cw.started {
+		if cw.writer != nil {
+			return cw.writer.Write(b)
+		}
+		return cw.ResponseWriter.Write(b)
+	}
+
+	if !cw.isCompressible() {
+		if err := cw.start(false); err != nil {
+			return 0, err
+		}
+		return cw.ResponseWriter.Write(b)
+	}
+
+	cw.buf = append(cw.buf, b...)
+	if len(cw.buf) >= cw.config.MinSize {
+		if err := cw.start(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+func (cw *compressResponseWriter) Flush() {
+	if !cw.started {
+		if cw.statusCode == 0 {
+			cw.statusCode = http.StatusOK
+		}
+		_ = cw.start(cw.isCompressible())
+	}
+
+	if f, ok := cw.writer.(interface{ Flush() error }); ok {
+		_ = f.Flush()
+	}
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (cw *compressResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := cw.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("response writer does not support hijacking")
+}
+
+func (cw *compressResponseWriter) isCompressible() bool {
+	h := cw.Header()
+	if h.Get("Content-Encoding") != "" || strings.HasPrefix(h.Get("Content-Type"), "image/") {
+		return false
+	}
+	return cw.statusCode >= http.StatusOK &&
+		cw.statusCode != http.StatusNoContent &&
+		cw.statusCode != http.StatusPartialContent &&
+		cw.statusCode != http.StatusNotModified
+}
+
+func (cw *compressResponseWriter) start(compress bool) error {
+	cw.started = true
+
+	h := cw.Header()
+	if compress || cw.statusCode == http.StatusNotModified {
+		if etag := h.Get("ETag"); strings.HasSuffix(etag, `"`) && !strings.HasPrefix(etag, "W/") {
+			h.Set("ETag", strings.TrimSuffix(etag, `"`)+"-"+cw.encoding+`"`)
+		}
+	}
+	if compress {
+		h.Set("Content-Encoding", cw.encoding)
+		h.Del("Content-Length")
+		cw.writer = cw.newWriter()
+	}
+	cw.ResponseWriter.WriteHeader(cw.statusCode)
+
+	buf := cw.buf
+	cw.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	if cw.writer != nil {
+		_, err := cw.writer.Write(buf)
+		return err
+	}
+	_, err := cw.ResponseWriter.Write(buf)
+	return err
+}
+
+func (cw *compressResponseWriter) newWriter() io.WriteCloser {
+	if cw.encoding == brotliEncoding {
+		return brotli.NewWriterLevel(cw.ResponseWriter, cw.config.BrotliLevel)
+	}
+
+	gw, err := gzip.NewWriterLevel(cw.ResponseWriter, cw.config.GzipLevel)
+	if err != nil {
+		return gzip.NewWriter(cw.ResponseWriter)
+	}
+	return gw
+}
+
+func (cw *compressResponseWriter) close() {
+	if !cw.started {
+		if cw.statusCode == 0 {
+			return
+		}
+		_ = cw.start(false)
+	}
+
+	if cw.writer != nil {
+		_ = cw.writer.Close()
+	}
+}
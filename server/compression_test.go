@@ -0,0 +1,159 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/codegangsta/negroni"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CompressionTestSuite struct {
+	suite.Suite
+	body string
+}
+
+func TestCompressionTestSuite(t *testing.T) {
+	suite.Run(t, new(CompressionTestSuite))
+}
+
+func (s *CompressionTestSuite) SetupTest() {
+	s.body = strings.Repeat(`{"title":"Bleach"}`, 100)
+}
+
+func (s *CompressionTestSuite) serve(acceptEncoding string, h http.HandlerFunc) *httptest.ResponseRecorder {
+	n := negroni.New()
+	n.Use(negroniCompressionHandler(config.CompressionConfig{MinSize: 1024, GzipLevel: 6, BrotliLevel: 5}))
+	n.UseHandlerFunc(h)
+
+	req, _ := http.NewRequest("GET", "/foo", nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	rr := httptest.NewRecorder()
+	n.ServeHTTP(rr, req)
+	return rr
+}
+
+func (s *CompressionTestSuite) writeBody(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func (s *CompressionTestSuite) TestNegotiateEncoding() {
+	assert.Equal(s.T(), "", negotiateEncoding(""))
+	assert.Equal(s.T(), "", negotiateEncoding("identity"))
+	assert.Equal(s.T(), "gzip", negotiateEncoding("gzip, deflate"))
+	assert.Equal(s.T(), "br", negotiateEncoding("gzip, deflate, br"))
+	assert.Equal(s.T(), "gzip", negotiateEncoding("gzip;q=1.0, br;q=0.5"))
+	assert.Equal(s.T(), "gzip", negotiateEncoding("br;q=0, *"))
+}
+
+func (s *CompressionTestSuite) TestCompression_ReturnsGzippedBody() {
+	rr := s.serve("gzip", s.writeBody(s.body))
+
+	assert.Equal(s.T(), http.StatusOK, rr.Code)
+	assert.Equal(s.T(), "gzip", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), `"abc-gzip"`, rr.Header().Get("ETag"))
+	assert.Equal(s.T(), "Accept-Encoding", rr.Header().Get("Vary"))
+
+	gr, err := gzip.NewReader(rr.Body)
+	assert.Nil(s.T(), err)
+	b, _ := ioutil.ReadAll(gr)
+	assert.Equal(s.T(), s.body, string(b))
+}
+
+func (s *CompressionTestSuite) TestCompression_ReturnsBrotliBody() {
+	rr := s.serve("gzip, br", s.writeBody(s.body))
+
+	assert.Equal(s.T(), "br", rr.Header().Get("Content-Encoding"))
+
+	b, _ := ioutil.ReadAll(brotli.NewReader(bytes.NewReader(rr.Body.Bytes())))
+	assert.Equal(s.T(), s.body, string(b))
+}
+
+func (s *CompressionTestSuite) TestCompression_SkipsSmallBody() {
+	rr := s.serve("gzip", s.writeBody(`{"success":true}`))
+
+	assert.Equal(s.T(), "", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), `"abc"`, rr.Header().Get("ETag"))
+	assert.Equal(s.T(), `{"success":true}`, rr.Body.String())
+}
+
+func (s *CompressionTestSuite) TestCompression_SkipsUnacceptedEncoding() {
+	rr := s.serve("", s.writeBody(s.body))
+
+	assert.Equal(s.T(), "", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), s.body, rr.Body.String())
+}
+
+func (s *CompressionTestSuite) TestCompression_SkipsAlreadyEncodedBody() {
+	rr := s.serve("gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "identity")
+		_, _ = w.Write([]byte(s.body))
+	})
+
+	assert.Equal(s.T(), "identity", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), s.body, rr.Body.String())
+}
+
+func (s *CompressionTestSuite) TestCompression_SkipsImageAndPartialContent() {
+	rr := s.serve("gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, _ = w.Write([]byte(s.body))
+	})
+
+	assert.Equal(s.T(), "", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), s.body, rr.Body.String())
+
+	rr = s.serve("gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte(s.body))
+	})
+
+	assert.Equal(s.T(), http.StatusPartialContent, rr.Code)
+	assert.Equal(s.T(), "", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), s.body, rr.Body.String())
+}
+
+func (s *CompressionTestSuite) TestCompression_PassesNotModifiedThrough() {
+	rr := s.serve("gzip", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	assert.Equal(s.T(), http.StatusNotModified, rr.Code)
+	assert.Equal(s.T(), "", rr.Header().Get("Content-Encoding"))
+	assert.Equal(s.T(), `"abc-gzip"`, rr.Header().Get("ETag"))
+}
+
+func (s *CompressionTestSuite) TestCompression_FlushesStreamedBody() {
+	rr := s.serve("gzip", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("foo"))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("bar"))
+	})
+
+	assert.True(s.T(), rr.Flushed)
+	assert.Equal(s.T(), "gzip", rr.Header().Get("Content-Encoding"))
+
+	gr, err := gzip.NewReader(rr.Body)
+	assert.Nil(s.T(), err)
+	b, _ := ioutil.ReadAll(gr)
+	assert.Equal(s.T(), "foobar", string(b))
+}
+
+func (s *CompressionTestSuite) TestStripETagEncoding() {
+	assert.Equal(s.T(), `"abc", W/"def"`, stripETagEncoding(`"abc-gzip", W/"def-br"`))
+}
@@ -14,8 +14,23 @@ func Router(deps service.Dependencies) *mux.Router {
 
 	router.HandleFunc("/ping", handler.PingHandler).Methods("GET")
 	router.HandleFunc(constants.GetMangasAPIPath, handler.GetMangas(deps.MangaService)).Methods("GET")
+	router.HandleFunc(constants.GetMangaAPIPath, handler.GetManga(deps.MangaService)).Methods("GET")
 	router.HandleFunc(constants.GetChaptersAPIPath, handler.GetChapters(deps.ChapterService)).Methods("GET")
 	router.HandleFunc(constants.GetContentsAPIPath, handler.GetContents(deps.ContentService)).Methods("GET")
+	router.HandleFunc(constants.GetBatchContentsAPIPath, handler.GetBatchContents(deps.BatchContentService)).Methods("GET")
+	router.HandleFunc(constants.GetGenresAPIPath, handler.GetGenres(deps.MangaService)).Methods("GET")
+	router.HandleFunc(constants.SearchAPIPath, handler.Search(deps.SearchService)).Methods("GET")
+	router.HandleFunc(constants.GetImageAPIPath, handler.GetImage(deps.ImageService)).Methods("GET")
+	router.HandleFunc(constants.SchemaDiagnosticsAPIPath, handler.GetSchemaDiagnostics(deps.DiagnosticsService)).Methods("GET")
+	router.HandleFunc(constants.RateLimitDiagnosticsAPIPath, handler.GetRateLimitDiagnostics(deps.DiagnosticsService)).Methods("GET")
+
+	router.HandleFunc(constants.GetMangasV2APIPath, handler.GetMangasV2(deps.MangaService)).Methods("GET")
+	router.HandleFunc(constants.GetMangaV2APIPath, handler.GetMangaV2(deps.MangaService)).Methods("GET")
+	router.HandleFunc(constants.GetChaptersV2APIPath, handler.GetChaptersV2(deps.ChapterService)).Methods("GET")
+	router.HandleFunc(constants.GetContentsV2APIPath, handler.GetContentsV2(deps.ContentService)).Methods("GET")
+	router.HandleFunc(constants.GetGenresV2APIPath, handler.GetGenres(deps.MangaService)).Methods("GET")
+	router.HandleFunc(constants.SearchV2APIPath, handler.SearchV2(deps.SearchService)).Methods("GET")
+
 	router.NotFoundHandler = http.HandlerFunc(handler.NotFoundHandler)
 
 	return router
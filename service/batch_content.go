@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+)
+
+type BatchContentService interface {
+	GetContents(ctx context.Context, req contract.BatchContentRequest) (*[]contract.BatchContent, error)
+}
+
+type batchContentService struct {
+	chapterService ChapterService
+	contentService ContentService
+}
+
+func (s *batchContentService) GetContents(ctx context.Context, req contract.BatchContentRequest) (*[]contract.BatchContent, error) {
+	chapters, err := s.getChapterNumbers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chapters) > constants.MaxBatchContentChapters {
+		return nil, mErr.NewValidationError(map[string]string{
+			constants.ChaptersKeyParam: fmt.Sprintf("%s cannot be more than %d", constants.ChaptersKeyParam,
+				constants.MaxBatchContentChapters),
+		})
+	}
+
+	results := make([]contract.BatchContent, len(chapters))
+	sem := make(chan struct{}, constants.BatchContentConcurrency)
+	var wg sync.WaitGroup
+	for i, c := range chapters {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, c domain.ChapterID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = s.getContent(ctx, req.TitleID, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	return &results, nil
+}
+
+func (s *batchContentService) getChapterNumbers(ctx context.Context, req contract.BatchContentRequest) ([]domain.ChapterID, error) {
+	if len(req.Chapters) > 0 {
+		return req.Chapters, nil
+	}
+
+	cr := contract.NewChapterRequest(req.TitleID)
+	cr.Order = constants.ChapterOrderAsc
+	cr.From = req.From
+	cr.To = req.To
+	cs, _, err := s.chapterService.GetChapters(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	var chapters []domain.ChapterID
+	for _, c := range *cs {
+		if id, err := domain.ParseChapterID(c.Number); err == nil {
+			chapters = append(chapters, id)
+		}
+	}
+
+	if len(chapters) == 0 {
+		return nil, mErr.NewNotFoundError("chapter")
+	}
+
+	return chapters, nil
+}
+
+func (s *batchContentService) getContent(ctx context.Context, titleID string, chapter domain.ChapterID) contract.BatchContent {
+	bc := contract.BatchContent{
+		Chapter:  chapter.String(),
+		Contents: []contract.Content{},
+	}
+
+	contents, err := s.contentService.GetContents(ctx, contract.ContentRequest{TitleID: titleID, Chapter: chapter})
+	if err != nil {
+		bc.Error = err.Error()
+		return bc
+	}
+
+	bc.Success = true
+	bc.Contents = *contents
+	bc.TotalPages = len(*contents)
+	return bc
+}
+
+func NewBatchContentService(chs ChapterService, cos ContentService) *batchContentService {
+	return &batchContentService{
+		chapterService: chs,
+		contentService: cos,
+	}
+}
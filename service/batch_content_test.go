@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchContentServiceTestSuite struct {
+	suite.Suite
+	chs *mock.ChapterServiceMock
+	cos *mock.ContentServiceMock
+}
+
+func TestBatchContentServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchContentServiceTestSuite))
+}
+
+func (s *BatchContentServiceTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *BatchContentServiceTestSuite) SetupTest() {
+	s.chs = &mock.ChapterServiceMock{}
+	s.cos = &mock.ContentServiceMock{}
+}
+
+func (s *BatchContentServiceTestSuite) TestGetContents_ReturnsResultPerChapter() {
+	contents := []contract.Content{{ImageURL: "http://foo.com/1.jpg", Page: 1}}
+	s.cos.On("GetContents", context.Background(), contract.ContentRequest{TitleID: "bleach", Chapter: "1"}).Return(&contents, nil)
+	s.cos.On("GetContents", context.Background(), contract.ContentRequest{TitleID: "bleach", Chapter: "2.5"}).
+		Return(nil, mErr.NewNotFoundError("content"))
+
+	bcs := NewBatchContentService(s.chs, s.cos)
+	res, err := bcs.GetContents(context.Background(), contract.NewBatchContentRequest("bleach", "1,2.5", "", ""))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.BatchContent{
+		{Chapter: "1", Success: true, Contents: contents, TotalPages: 1},
+		{Chapter: "2.5", Success: false, Contents: []contract.Content{}, Error: "Could not find content"},
+	}, *res)
+	s.chs.AssertNotCalled(s.T(), "GetChapters", context.Background())
+}
+
+func (s *BatchContentServiceTestSuite) TestGetContents_ResolvesChapterRange() {
+	req := contract.NewBatchContentRequest("bleach", "", "1", "2")
+	cr := contract.NewChapterRequest("bleach")
+	cr.Order = constants.ChapterOrderAsc
+	cr.From = req.From
+	cr.To = req.To
+	chapters := []contract.Chapter{{Number: "1"}, {Number: "2"}}
+	s.chs.On("GetChapters", context.Background(), cr).Return(&chapters, 2, nil)
+
+	contents := []contract.Content{{ImageURL: "http://foo.com/1.jpg", Page: 1}}
+	s.cos.On("GetContents", context.Background(), contract.ContentRequest{TitleID: "bleach", Chapter: "1"}).Return(&contents, nil)
+	s.cos.On("GetContents", context.Background(), contract.ContentRequest{TitleID: "bleach", Chapter: "2"}).Return(&contents, nil)
+
+	bcs := NewBatchContentService(s.chs, s.cos)
+	res, err := bcs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(*res))
+	assert.Equal(s.T(), "1", (*res)[0].Chapter)
+	assert.Equal(s.T(), "2", (*res)[1].Chapter)
+}
+
+func (s *BatchContentServiceTestSuite) TestGetContents_ReturnsError_WhenRangeHasNoChapters() {
+	req := contract.NewBatchContentRequest("bleach", "", "100", "")
+	cr := contract.NewChapterRequest("bleach")
+	cr.Order = constants.ChapterOrderAsc
+	cr.From = req.From
+	s.chs.On("GetChapters", context.Background(), cr).Return(&[]contract.Chapter{}, 0, nil)
+
+	bcs := NewBatchContentService(s.chs, s.cos)
+	res, err := bcs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), mErr.NewNotFoundError("chapter"), err)
+}
+
+func (s *BatchContentServiceTestSuite) TestGetContents_ReturnsError_WhenBatchIsTooLarge() {
+	req := contract.NewBatchContentRequest("bleach", "", "", "")
+	for i := 1; i <= constants.MaxBatchContentChapters+1; i++ {
+		req.Chapters = append(req.Chapters, domain.ChapterID(strconv.Itoa(i)))
+	}
+
+	bcs := NewBatchContentService(s.chs, s.cos)
+	res, err := bcs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), res)
+	assert.Equal(s.T(), "chapters cannot be more than 20", err.Error())
+	s.cos.AssertNotCalled(s.T(), "GetContents", context.Background())
+}
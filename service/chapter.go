@@ -1,17 +1,21 @@
 package service
 
 import (
+	"context"
+	"sort"
+
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
 	"github.com/bigscreen/mangindo-feeder/client"
 	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
 )
 
 type ChapterService interface {
-	GetChapters(req contract.ChapterRequest) (*[]contract.Chapter, error)
+	GetChapters(ctx context.Context, req contract.ChapterRequest) (chapters *[]contract.Chapter, total int, err error)
 }
 
 type chapterService struct {
@@ -20,35 +24,111 @@ type chapterService struct {
 	workerService       WorkerService
 }
 
-func (s *chapterService) GetChapters(req contract.ChapterRequest) (*[]contract.Chapter, error) {
-	cl, err := s.chapterCacheManager.GetCache(req.TitleID)
-	if err != nil {
-		cl, err = s.chapterClient.GetChapterList(req.TitleID)
-		if err != nil {
-			return nil, mErr.NewGenericError()
+func getUniqueChapters(dcs []domain.Chapter) []domain.Chapter {
+	seen := map[domain.ChapterID]bool{}
+	var unique []domain.Chapter
+	for _, dc := range dcs {
+		if seen[dc.Number] {
+			continue
+		}
+		seen[dc.Number] = true
+		unique = append(unique, dc)
+	}
+	return unique
+}
+
+func getChaptersInRange(dcs []domain.Chapter, from, to *domain.ChapterID) []domain.Chapter {
+	var filtered []domain.Chapter
+	for _, dc := range dcs {
+		if from != nil && dc.Number.Compare(*from) < 0 {
+			continue
+		}
+		if to != nil && dc.Number.Compare(*to) > 0 {
+			continue
 		}
+		filtered = append(filtered, dc)
+	}
+	return filtered
+}
+
+func sortChapters(dcs []domain.Chapter, order string) {
+	sort.SliceStable(dcs, func(i, j int) bool {
+		if order == constants.ChapterOrderAsc {
+			return dcs[i].Number.Compare(dcs[j].Number) < 0
+		}
+		return dcs[i].Number.Compare(dcs[j].Number) > 0
+	})
+}
+
+func getChapterPage(dcs []domain.Chapter, page, limit int) []domain.Chapter {
+	if limit == 0 {
+		return dcs
+	}
+
+	start := (page - 1) * limit
+	if start >= len(dcs) {
+		return []domain.Chapter{}
+	}
+
+	end := start + limit
+	if end > len(dcs) {
+		end = len(dcs)
+	}
+	return dcs[start:end]
+}
 
-		err = s.workerService.SetChapterCache(req.TitleID)
+func (s *chapterService) GetChapters(ctx context.Context, req contract.ChapterRequest) (chapters *[]contract.Chapter, total int, err error) {
+	cl, f, err := s.chapterCacheManager.GetCacheEntry(ctx, req.TitleID)
+	if err == nil {
+		common.RecordCacheAge(ctx, f.Age, f.Stale)
+		if f.Refresh {
+			s.enqueueSetChapterCache(req.TitleID)
+		}
+	} else {
+		cl, err = s.getOriginChapterList(ctx, req.TitleID)
 		if err != nil {
-			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetChapterCacheJob, err.Error())
+			return nil, 0, getOriginFetchError(err)
 		}
 	}
 
 	if len(cl.Chapters) == 0 {
-		return nil, mErr.NewNotFoundError("chapter")
+		return nil, 0, mErr.NewNotFoundError("chapter")
+	}
+
+	dcs := getChaptersInRange(getUniqueChapters(cl.Chapters), req.From, req.To)
+	sortChapters(dcs, req.Order)
+
+	cs := []contract.Chapter{}
+	for _, dc := range getChapterPage(dcs, req.Page, req.Limit) {
+		cs = append(cs, *getMappedChapter(&dc))
 	}
 
-	var chapters []contract.Chapter
-	for _, dc := range cl.Chapters {
-		chapter := contract.Chapter{
-			Number:  common.GetFormattedChapterNumber(dc.Number),
-			Title:   dc.Title,
-			TitleID: dc.TitleID,
+	return &cs, len(dcs), nil
+}
+
+// getOriginChapterList fetches a title missing from the cache, sharing the
+// origin call and the cache job with concurrent requests for it.
+func (s *chapterService) getOriginChapterList(ctx context.Context, titleID string) (*domain.ChapterListResponse, error) {
+	v, err := originFlights.do(ctx, "ChapterList|"+titleID, func() (interface{}, error) {
+		cl, err := s.chapterClient.GetChapterList(ctx, titleID)
+		if err != nil {
+			return nil, err
 		}
-		chapters = append(chapters, chapter)
+
+		s.enqueueSetChapterCache(titleID)
+		return cl, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return v.(*domain.ChapterListResponse), nil
+}
 
-	return &chapters, nil
+func (s *chapterService) enqueueSetChapterCache(titleID string) {
+	err := s.workerService.SetChapterCache(titleID)
+	if err != nil {
+		logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetChapterCacheJob, err.Error())
+	}
 }
 
 func NewChapterService(cc client.ChapterClient, ccm manager.ChapterCacheManager, ws WorkerService) *chapterService {
@@ -1,8 +1,10 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
@@ -22,6 +24,7 @@ type ChapterServiceTestSuite struct {
 	suite.Suite
 	cca cache.ChapterCache
 	cc  *mock.ChapterClientMock
+	mcm manager.MangaCacheManager
 	ws  *mock.WorkerServiceMock
 }
 
@@ -38,17 +41,18 @@ func (s *ChapterServiceTestSuite) SetupSuite() {
 func (s *ChapterServiceTestSuite) SetupTest() {
 	s.cca = cache.NewChapterCache()
 	s.cc = &mock.ChapterClientMock{}
+	s.mcm = manager.NewMangaCacheManager(&mock.MangaClientMock{}, cache.NewMangaCache())
 	s.ws = &mock.WorkerServiceMock{}
 }
 
 func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsError_WhenCacheMissesAndClientReturnsError() {
-	ccm := manager.NewChapterCacheManager(s.cc, s.cca)
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
 
 	req := contract.NewChapterRequest("bleach")
-	s.cc.On("GetChapterList", req.TitleID).Return(nil, errors.New("some error"))
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(nil, errors.New("some error"))
 
 	cs := NewChapterService(s.cc, ccm, s.ws)
-	cl, err := cs.GetChapters(req)
+	cl, _, err := cs.GetChapters(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewGenericError().Error(), err.Error())
@@ -57,38 +61,68 @@ func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsError_WhenCacheMissesAn
 	s.ws.AssertNotCalled(s.T(), "SetChapterCache", req.TitleID)
 }
 
+func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsOriginError_WhenCacheMissesAndOriginFails() {
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
+
+	req := contract.NewChapterRequest("bleach")
+	originErr := mErr.NewOriginStatusError(http.StatusInternalServerError)
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(nil, originErr)
+
+	cs := NewChapterService(s.cc, ccm, s.ws)
+	cl, _, err := cs.GetChapters(context.Background(), req)
+
+	assert.Nil(s.T(), cl)
+	assert.Equal(s.T(), originErr, err)
+	assert.Equal(s.T(), http.StatusBadGateway, mErr.GetStatusCodeOf(err))
+}
+
+func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsRateLimitedError_WhenOriginCallIsRejected() {
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
+
+	req := contract.NewChapterRequest("bleach")
+	rateErr := mErr.NewRateLimitedError("GetChapterList")
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(nil, rateErr)
+
+	cs := NewChapterService(s.cc, ccm, s.ws)
+	cl, _, err := cs.GetChapters(context.Background(), req)
+
+	assert.Nil(s.T(), cl)
+	assert.Equal(s.T(), rateErr, err)
+	assert.Equal(s.T(), http.StatusServiceUnavailable, mErr.GetStatusCodeOf(err))
+}
+
 func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsError_WhenCacheHitsAndChapterListIsEmpty() {
-	ccm := manager.NewChapterCacheManager(s.cc, s.cca)
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
 
 	req := contract.NewChapterRequest("bleach")
 	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{}}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, string(cb), config.CacheTTLs().Chapter)
 	defer func() {
-		_ = s.cca.Delete(req.TitleID)
+		_ = s.cca.Delete(context.Background(), req.TitleID)
 	}()
 
 	cs := NewChapterService(s.cc, ccm, s.ws)
-	chapters, err := cs.GetChapters(req)
+	chapters, _, err := cs.GetChapters(context.Background(), req)
 
 	assert.Nil(s.T(), chapters)
 	assert.Equal(s.T(), mErr.NewNotFoundError("chapter").Error(), err.Error())
 
-	s.ws.AssertNotCalled(s.T(), "GetChapterList", req.TitleID)
+	s.ws.AssertNotCalled(s.T(), "GetChapterList", context.Background(), req.TitleID)
 	s.ws.AssertNotCalled(s.T(), "SetChapterCache", req.TitleID)
 }
 
 func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsError_WhenCacheMissesAndChapterListIsEmpty() {
-	ccm := manager.NewChapterCacheManager(s.cc, s.cca)
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
 
 	req := contract.NewChapterRequest("bleach")
 	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{}}
 
-	s.cc.On("GetChapterList", req.TitleID).Return(&cr, nil)
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(&cr, nil)
 	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
 
 	cs := NewChapterService(s.cc, ccm, s.ws)
-	chapters, err := cs.GetChapters(req)
+	chapters, _, err := cs.GetChapters(context.Background(), req)
 
 	assert.Nil(s.T(), chapters)
 	assert.Equal(s.T(), mErr.NewNotFoundError("chapter").Error(), err.Error())
@@ -98,24 +132,24 @@ func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsError_WhenCacheMissesAn
 }
 
 func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsSuccess_WhenCacheHitsAndChapterListIsNotEmpty() {
-	ccm := manager.NewChapterCacheManager(s.cc, s.cca)
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
 
 	req := contract.NewChapterRequest("bleach")
 	dc := domain.Chapter{
-		Number:       650.0,
+		Number:       "650",
 		Title:        "Bleach",
 		TitleID:      "bleach",
 		ModifiedDate: "2016-08-18 18:59:58",
 	}
 	cr := &domain.ChapterListResponse{Chapters: []domain.Chapter{dc}}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, string(cb), config.CacheTTLs().Chapter)
 	defer func() {
-		_ = s.cca.Delete(req.TitleID)
+		_ = s.cca.Delete(context.Background(), req.TitleID)
 	}()
 
 	cs := NewChapterService(s.cc, ccm, s.ws)
-	chapters, err := cs.GetChapters(req)
+	chapters, _, err := cs.GetChapters(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*chapters) > 0)
@@ -123,27 +157,27 @@ func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsSuccess_WhenCacheHitsAn
 	assert.Equal(s.T(), dc.Title, (*chapters)[0].Title)
 	assert.Equal(s.T(), dc.TitleID, (*chapters)[0].TitleID)
 
-	s.ws.AssertNotCalled(s.T(), "GetChapterList", req.TitleID)
+	s.ws.AssertNotCalled(s.T(), "GetChapterList", context.Background(), req.TitleID)
 	s.ws.AssertNotCalled(s.T(), "SetChapterCache", req.TitleID)
 }
 
 func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsSuccess_WhenCacheMissesAndChapterListIsNotEmpty() {
-	ccm := manager.NewChapterCacheManager(s.cc, s.cca)
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
 
 	req := contract.NewChapterRequest("bleach")
 	dc := domain.Chapter{
-		Number:       650.0,
+		Number:       "650",
 		Title:        "Bleach",
 		TitleID:      "bleach",
 		ModifiedDate: "2016-08-18 18:59:58",
 	}
 	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{dc}}
 
-	s.cc.On("GetChapterList", req.TitleID).Return(&cr, nil)
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(&cr, nil)
 	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
 
 	cs := NewChapterService(s.cc, ccm, s.ws)
-	chapters, err := cs.GetChapters(req)
+	chapters, _, err := cs.GetChapters(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*chapters) > 0)
@@ -154,3 +188,73 @@ func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsSuccess_WhenCacheMisses
 	s.cc.AssertExpectations(s.T())
 	s.ws.AssertExpectations(s.T())
 }
+
+func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsUniqueSortedPage_WhenPaginationIsRequested() {
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
+
+	req := contract.NewPagedChapterRequest("bleach", "2", "2", "asc", "", "")
+	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{
+		getFakeChapter("657.5"), getFakeChapter("656"), getFakeChapter("657"), getFakeChapter("656"), getFakeChapter("655"),
+	}}
+
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(&cr, nil)
+	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
+
+	cs := NewChapterService(s.cc, ccm, s.ws)
+	chapters, total, err := cs.GetChapters(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 4, total)
+	assert.Equal(s.T(), 2, len(*chapters))
+	assert.Equal(s.T(), "657", (*chapters)[0].Number)
+	assert.Equal(s.T(), "657.5", (*chapters)[1].Number)
+
+	s.cc.AssertExpectations(s.T())
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsChaptersInRange_WhenBoundsAreRequested() {
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
+
+	req := contract.NewPagedChapterRequest("bleach", "", "", "", "656", "657")
+	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{
+		getFakeChapter("655"), getFakeChapter("656"), getFakeChapter("657"), getFakeChapter("657.5"),
+	}}
+
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(&cr, nil)
+	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
+
+	cs := NewChapterService(s.cc, ccm, s.ws)
+	chapters, total, err := cs.GetChapters(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, total)
+	assert.Equal(s.T(), "657", (*chapters)[0].Number)
+	assert.Equal(s.T(), "656", (*chapters)[1].Number)
+}
+
+func (s *ChapterServiceTestSuite) TestGetChapters_ReturnsEmptyPage_WhenPageIsOutOfRange() {
+	ccm := manager.NewChapterCacheManager(s.cc, s.mcm, s.cca)
+
+	req := contract.NewPagedChapterRequest("bleach", "5", "10", "", "", "")
+	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{getFakeChapter("655"), getFakeChapter("656")}}
+
+	s.cc.On("GetChapterList", context.Background(), req.TitleID).Return(&cr, nil)
+	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
+
+	cs := NewChapterService(s.cc, ccm, s.ws)
+	chapters, total, err := cs.GetChapters(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, total)
+	assert.Empty(s.T(), *chapters)
+}
+
+func getFakeChapter(number domain.ChapterID) domain.Chapter {
+	return domain.Chapter{
+		Number:       number,
+		Title:        "Bleach",
+		TitleID:      "bleach",
+		ModifiedDate: "2016-08-18 18:59:58",
+	}
+}
@@ -1,24 +1,32 @@
 package service
 
 import (
+	"context"
+	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
 	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
 )
 
 type ContentService interface {
-	GetContents(req contract.ContentRequest) (*[]contract.Content, error)
+	GetContents(ctx context.Context, req contract.ContentRequest) (*[]contract.Content, error)
+	GetNavigation(ctx context.Context, req contract.ContentRequest) (previous *contract.Chapter, next *contract.Chapter)
 }
 
 type contentService struct {
 	contentClient       client.ContentClient
 	contentCacheManager manager.ContentCacheManager
+	contentMetaManager  manager.ContentMetaCacheManager
+	chapterCacheManager manager.ChapterCacheManager
 	workerService       WorkerService
 }
 
@@ -26,6 +34,22 @@ func getEncodedURL(url string) string {
 	return strings.Replace(url, " ", "%20", -1)
 }
 
+// getImageURL points images on the allowed image hosts at the image proxy
+// when URL rewriting is enabled, keeping the host in the proxied path. Images
+// hosted elsewhere are left to the client.
+func getImageURL(imageURL string) string {
+	proxy := config.ImageProxy()
+	if !proxy.RewriteURLs {
+		return getEncodedURL(imageURL)
+	}
+
+	u, err := url.Parse(imageURL)
+	if err != nil || !isImageHostAllowed(u.Host) || u.RawQuery != "" {
+		return getEncodedURL(imageURL)
+	}
+	return proxy.BaseURL + constants.ImageProxyAPIPath + "/" + strings.ToLower(u.Host) + u.EscapedPath()
+}
+
 func isAdsContentURL(url string) bool {
 	for _, tag := range config.AdsContentTags() {
 		if strings.Contains(url, tag) {
@@ -35,17 +59,17 @@ func isAdsContentURL(url string) bool {
 	return false
 }
 
-func (s *contentService) GetContents(req contract.ContentRequest) (*[]contract.Content, error) {
-	cl, err := s.contentCacheManager.GetCache(req.TitleID, req.Chapter)
-	if err != nil {
-		cl, err = s.contentClient.GetContentList(req.TitleID, req.Chapter)
-		if err != nil {
-			return nil, mErr.NewGenericError()
+func (s *contentService) GetContents(ctx context.Context, req contract.ContentRequest) (*[]contract.Content, error) {
+	cl, f, err := s.contentCacheManager.GetCacheEntry(ctx, req.TitleID, req.Chapter)
+	if err == nil {
+		common.RecordCacheAge(ctx, f.Age, f.Stale)
+		if f.Refresh {
+			s.enqueueSetContentCache(req)
 		}
-
-		err = s.workerService.SetContentCache(req.TitleID, req.Chapter)
+	} else {
+		cl, err = s.getOriginContentList(ctx, req)
 		if err != nil {
-			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetContentCacheJob, err.Error())
+			return nil, getOriginFetchError(err)
 		}
 	}
 
@@ -53,10 +77,19 @@ func (s *contentService) GetContents(req contract.ContentRequest) (*[]contract.C
 		return nil, mErr.NewNotFoundError("content")
 	}
 
+	images := s.getImageMetas(ctx, req)
+
 	var contents []contract.Content
 	for _, dc := range cl.Contents {
 		if !isAdsContentURL(dc.ImageURL) {
-			content := contract.Content{ImageURL: getEncodedURL(dc.ImageURL)}
+			meta := images[dc.ImageURL]
+			content := contract.Content{
+				ImageURL: getImageURL(dc.ImageURL),
+				Page:     dc.Page,
+				Width:    meta.Width,
+				Height:   meta.Height,
+				Size:     meta.Size,
+			}
 			contents = append(contents, content)
 		}
 	}
@@ -65,13 +98,89 @@ func (s *contentService) GetContents(req contract.ContentRequest) (*[]contract.C
 		return nil, mErr.NewNotFoundError("content")
 	}
 
+	sort.SliceStable(contents, func(i, j int) bool {
+		return contents[i].Page < contents[j].Page
+	})
+
 	return &contents, nil
 }
 
-func NewContentService(cc client.ContentClient, ccm manager.ContentCacheManager, ws WorkerService) *contentService {
+// getOriginContentList fetches a chapter missing from the cache, sharing the
+// origin call and the cache job with concurrent requests for it.
+func (s *contentService) getOriginContentList(ctx context.Context, req contract.ContentRequest) (*domain.ContentListResponse, error) {
+	v, err := originFlights.do(ctx, "ContentList|"+req.TitleID+"|"+req.Chapter.String(), func() (interface{}, error) {
+		cl, err := s.contentClient.GetContentList(ctx, req.TitleID, req.Chapter)
+		if err != nil {
+			return nil, err
+		}
+
+		s.enqueueSetContentCache(req)
+		return cl, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*domain.ContentListResponse), nil
+}
+
+func (s *contentService) enqueueSetContentCache(req contract.ContentRequest) {
+	err := s.workerService.SetContentCache(req.TitleID, req.Chapter)
+	if err != nil {
+		logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetContentCacheJob, err.Error())
+	}
+}
+
+// getImageMetas returns the probed page images of a chapter, or nothing when
+// the probing job has not finished yet.
+func (s *contentService) getImageMetas(ctx context.Context, req contract.ContentRequest) map[string]domain.ImageMeta {
+	cm, err := s.contentMetaManager.GetCache(ctx, req.TitleID, req.Chapter)
+	if err != nil {
+		return nil
+	}
+	return cm.Images
+}
+
+func getMappedChapter(dc *domain.Chapter) *contract.Chapter {
+	if dc == nil {
+		return nil
+	}
+	return &contract.Chapter{
+		Number:       dc.Number.String(),
+		Title:        dc.Title,
+		TitleID:      dc.TitleID,
+		ModifiedDate: dc.ModifiedDate,
+	}
+}
+
+func (s *contentService) GetNavigation(ctx context.Context, req contract.ContentRequest) (previous *contract.Chapter, next *contract.Chapter) {
+	cl, err := s.chapterCacheManager.GetCache(ctx, req.TitleID)
+	if err != nil {
+		err = s.workerService.SetChapterCache(req.TitleID)
+		if err != nil {
+			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetChapterCacheJob, err.Error())
+		}
+		return nil, nil
+	}
+
+	var prev, nxt *domain.Chapter
+	for i, dc := range cl.Chapters {
+		if dc.Number.Compare(req.Chapter) < 0 && (prev == nil || dc.Number.Compare(prev.Number) > 0) {
+			prev = &cl.Chapters[i]
+		}
+		if dc.Number.Compare(req.Chapter) > 0 && (nxt == nil || dc.Number.Compare(nxt.Number) < 0) {
+			nxt = &cl.Chapters[i]
+		}
+	}
+
+	return getMappedChapter(prev), getMappedChapter(nxt)
+}
+
+func NewContentService(cc client.ContentClient, ccm manager.ContentCacheManager, cmm manager.ContentMetaCacheManager, chcm manager.ChapterCacheManager, ws WorkerService) *contentService {
 	return &contentService{
 		contentClient:       cc,
 		contentCacheManager: ccm,
+		contentMetaManager:  cmm,
+		chapterCacheManager: chcm,
 		workerService:       ws,
 	}
 }
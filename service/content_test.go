@@ -1,16 +1,19 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
-	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/contract"
 	"github.com/bigscreen/mangindo-feeder/domain"
@@ -23,9 +26,13 @@ import (
 
 type ContentServiceTestSuite struct {
 	suite.Suite
-	cca cache.ContentCache
-	cc  *mock.ContentClientMock
-	ws  *mock.WorkerServiceMock
+	cca  cache.ContentCache
+	cmca cache.ContentMetaCache
+	chca cache.ChapterCache
+	cc   *mock.ContentClientMock
+	cmm  manager.ContentMetaCacheManager
+	chcm manager.ChapterCacheManager
+	ws   *mock.WorkerServiceMock
 }
 
 func TestContentServiceTestSuite(t *testing.T) {
@@ -40,7 +47,11 @@ func (s *ContentServiceTestSuite) SetupSuite() {
 
 func (s *ContentServiceTestSuite) SetupTest() {
 	s.cca = cache.NewContentCache()
+	s.chca = cache.NewChapterCache()
+	s.cmca = cache.NewContentMetaCache()
 	s.cc = &mock.ContentClientMock{}
+	s.cmm = manager.NewContentMetaCacheManager(&mock.ImageClientMock{}, manager.NewContentCacheManager(s.cc, s.cca), s.cmca)
+	s.chcm = manager.NewChapterCacheManager(&mock.ChapterClientMock{}, manager.NewMangaCacheManager(&mock.MangaClientMock{}, cache.NewMangaCache()), s.chca)
 	s.ws = &mock.WorkerServiceMock{}
 }
 
@@ -48,10 +59,10 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheMissesAn
 	ccm := manager.NewContentCacheManager(s.cc, s.cca)
 
 	req := contract.NewContentRequest("bleach", "650")
-	s.cc.On("GetContentList", req.TitleID, req.Chapter).Return(nil, errors.New("some error"))
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(nil, errors.New("some error"))
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewGenericError().Error(), err.Error())
@@ -60,27 +71,57 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheMissesAn
 	s.ws.AssertNotCalled(s.T(), "SetContentCache", req.TitleID, req.Chapter)
 }
 
+func (s *ContentServiceTestSuite) TestGetContents_ReturnsOriginError_WhenCacheMissesAndOriginFails() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "650")
+	originErr := mErr.NewOriginUnavailableError("timeout")
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(nil, originErr)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), cl)
+	assert.Equal(s.T(), originErr, err)
+	assert.Equal(s.T(), http.StatusBadGateway, mErr.GetStatusCodeOf(err))
+}
+
+func (s *ContentServiceTestSuite) TestGetContents_ReturnsRateLimitedError_WhenOriginCallIsRejected() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "650")
+	rateErr := mErr.NewRateLimitedError("GetContentList")
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(nil, rateErr)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), cl)
+	assert.Equal(s.T(), rateErr, err)
+	assert.Equal(s.T(), http.StatusServiceUnavailable, mErr.GetStatusCodeOf(err))
+}
+
 func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheHitsAndContentListIsEmpty() {
 	ccm := manager.NewContentCacheManager(s.cc, s.cca)
 
 	req := contract.NewContentRequest("bleach", "650")
-	sch := common.GetFormattedChapterNumber(req.Chapter)
+	sch := req.Chapter.String()
 	cr := domain.ContentListResponse{
 		Contents: []domain.Content{},
 	}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, sch, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, sch, string(cb))
 	defer func() {
-		_ = s.cca.Delete(req.TitleID, sch)
+		_ = s.cca.Delete(context.Background(), req.TitleID, sch)
 	}()
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewNotFoundError("content").Error(), err.Error())
 
-	s.cc.AssertNotCalled(s.T(), "GetContentList", req.TitleID, req.Chapter)
+	s.cc.AssertNotCalled(s.T(), "GetContentList", context.Background(), req.TitleID, req.Chapter)
 	s.ws.AssertNotCalled(s.T(), "SetContentCache", req.TitleID, req.Chapter)
 }
 
@@ -89,11 +130,11 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheMissesAn
 	req := contract.NewContentRequest("bleach", "650")
 	cr := domain.ContentListResponse{Contents: []domain.Content{}}
 
-	s.cc.On("GetContentList", req.TitleID, req.Chapter).Return(&cr, nil)
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
 	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewNotFoundError("content").Error(), err.Error())
@@ -107,14 +148,14 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheHitsAndC
 	ccm := manager.NewContentCacheManager(s.cc, s.cca)
 
 	req := contract.NewContentRequest("bleach", "650")
-	sch := common.GetFormattedChapterNumber(req.Chapter)
+	sch := req.Chapter.String()
 	cr := domain.ContentListResponse{
 		Contents: []domain.Content{getFakeAdsContent(1, "ads")},
 	}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, sch, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, sch, string(cb))
 	defer func() {
-		_ = s.cca.Delete(req.TitleID, sch)
+		_ = s.cca.Delete(context.Background(), req.TitleID, sch)
 	}()
 
 	_ = os.Setenv("ADS_CONTENT_TAGS", "ads")
@@ -124,13 +165,13 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheHitsAndC
 		config.Load()
 	}()
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewNotFoundError("content").Error(), err.Error())
 
-	s.cc.AssertNotCalled(s.T(), "GetContentList", req.TitleID, req.Chapter)
+	s.cc.AssertNotCalled(s.T(), "GetContentList", context.Background(), req.TitleID, req.Chapter)
 	s.ws.AssertNotCalled(s.T(), "SetContentCache", req.TitleID, req.Chapter)
 }
 
@@ -150,11 +191,11 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheMissesAn
 		config.Load()
 	}()
 
-	s.cc.On("GetContentList", req.TitleID, req.Chapter).Return(&cr, nil)
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
 	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), cl)
 	assert.Equal(s.T(), mErr.NewNotFoundError("content").Error(), err.Error())
@@ -163,20 +204,50 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsError_WhenCacheMissesAn
 	s.ws.AssertExpectations(s.T())
 }
 
+func (s *ContentServiceTestSuite) TestGetContents_MakesOneOriginCallAndJob_WhenConcurrentRequestsMissCache() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "650")
+	cr := domain.ContentListResponse{
+		Contents: []domain.Content{getFakeContent(1)},
+	}
+
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).After(50*time.Millisecond).Return(&cr, nil).Once()
+	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil).Once()
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cl, err := cs.GetContents(context.Background(), req)
+
+			assert.Nil(s.T(), err)
+			assert.Equal(s.T(), 1, len(*cl))
+		}()
+	}
+	wg.Wait()
+
+	s.cc.AssertNumberOfCalls(s.T(), "GetContentList", 1)
+	s.ws.AssertNumberOfCalls(s.T(), "SetContentCache", 1)
+}
+
 func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenCacheHitsAndContentListContainsOnlyNonAdsContent() {
 	tags := os.Getenv("ADS_CONTENT_TAGS")
 	ccm := manager.NewContentCacheManager(s.cc, s.cca)
 
 	req := contract.NewContentRequest("bleach", "650")
-	sch := common.GetFormattedChapterNumber(req.Chapter)
+	sch := req.Chapter.String()
 	ct := getFakeContent(1)
 	cr := domain.ContentListResponse{
 		Contents: []domain.Content{ct},
 	}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, sch, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, sch, string(cb))
 	defer func() {
-		_ = s.cca.Delete(req.TitleID, sch)
+		_ = s.cca.Delete(context.Background(), req.TitleID, sch)
 	}()
 
 	_ = os.Setenv("ADS_CONTENT_TAGS", "ads")
@@ -186,14 +257,14 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenCacheHitsAn
 		config.Load()
 	}()
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*cl) > 0)
 	assert.Equal(s.T(), getEncodedURL(ct.ImageURL), (*cl)[0].ImageURL)
 
-	s.cc.AssertNotCalled(s.T(), "GetContentList", req.TitleID, req.Chapter)
+	s.cc.AssertNotCalled(s.T(), "GetContentList", context.Background(), req.TitleID, req.Chapter)
 	s.ws.AssertNotCalled(s.T(), "SetContentCache", req.TitleID, req.Chapter)
 }
 
@@ -214,11 +285,11 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenCacheMisses
 		config.Load()
 	}()
 
-	s.cc.On("GetContentList", req.TitleID, req.Chapter).Return(&cr, nil)
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
 	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*cl) > 0)
@@ -233,16 +304,16 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenCacheHitsAn
 	ccm := manager.NewContentCacheManager(s.cc, s.cca)
 
 	req := contract.NewContentRequest("bleach", "650")
-	sch := common.GetFormattedChapterNumber(req.Chapter)
+	sch := req.Chapter.String()
 	ct1 := getFakeAdsContent(1, "ads")
 	ct2 := getFakeContent(2)
 	cr := domain.ContentListResponse{
 		Contents: []domain.Content{ct1, ct2},
 	}
 	cb, _ := json.Marshal(cr)
-	_ = s.cca.Set(req.TitleID, sch, string(cb))
+	_ = s.cca.Set(context.Background(), req.TitleID, sch, string(cb))
 	defer func() {
-		_ = s.cca.Delete(req.TitleID, sch)
+		_ = s.cca.Delete(context.Background(), req.TitleID, sch)
 	}()
 
 	_ = os.Setenv("ADS_CONTENT_TAGS", "ads")
@@ -252,14 +323,14 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenCacheHitsAn
 		config.Load()
 	}()
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*cl) > 0)
 	assert.Equal(s.T(), getEncodedURL(ct2.ImageURL), (*cl)[0].ImageURL)
 
-	s.cc.AssertNotCalled(s.T(), "GetContentList", req.TitleID, req.Chapter)
+	s.cc.AssertNotCalled(s.T(), "GetContentList", context.Background(), req.TitleID, req.Chapter)
 	s.ws.AssertNotCalled(s.T(), "SetContentCache", req.TitleID, req.Chapter)
 }
 
@@ -281,20 +352,193 @@ func (s *ContentServiceTestSuite) TestGetContents_ReturnsSuccess_WhenContentList
 		config.Load()
 	}()
 
-	s.cc.On("GetContentList", req.TitleID, req.Chapter).Return(&cr, nil)
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
 	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
 
-	cs := NewContentService(s.cc, ccm, s.ws)
-	cl, err := cs.GetContents(req)
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
 
 	assert.Nil(s.T(), err)
 	assert.True(s.T(), len(*cl) > 0)
 	assert.Equal(s.T(), getEncodedURL(ct2.ImageURL), (*cl)[0].ImageURL)
+	assert.Equal(s.T(), ct2.Page, (*cl)[0].Page)
+
+	s.cc.AssertExpectations(s.T())
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *ContentServiceTestSuite) TestGetContents_ReturnsContentsSortedByPage_WhenOriginIsOutOfOrder() {
+	tags := os.Getenv("ADS_CONTENT_TAGS")
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "650")
+	cr := domain.ContentListResponse{
+		Contents: []domain.Content{getFakeContent(3), getFakeAdsContent(1, "ads"), getFakeContent(2)},
+	}
+
+	_ = os.Setenv("ADS_CONTENT_TAGS", "ads")
+	config.Load()
+	defer func() {
+		_ = os.Setenv("ADS_CONTENT_TAGS", tags)
+		config.Load()
+	}()
+
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
+	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, len(*cl))
+	assert.Equal(s.T(), 2, (*cl)[0].Page)
+	assert.Equal(s.T(), 3, (*cl)[1].Page)
+
+	s.cc.AssertExpectations(s.T())
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *ContentServiceTestSuite) TestGetContents_RewritesOriginImageURLs_WhenImageProxyIsEnabled() {
+	env := map[string]string{
+		"ORIGIN_SERVER_BASE_URL":    os.Getenv("ORIGIN_SERVER_BASE_URL"),
+		"IMAGE_PROXY_REWRITE_URLS":  os.Getenv("IMAGE_PROXY_REWRITE_URLS"),
+		"IMAGE_PROXY_BASE_URL":      os.Getenv("IMAGE_PROXY_BASE_URL"),
+		"IMAGE_PROXY_ALLOWED_HOSTS": os.Getenv("IMAGE_PROXY_ALLOWED_HOSTS"),
+	}
+	_ = os.Setenv("ORIGIN_SERVER_BASE_URL", "http://foo.com")
+	_ = os.Setenv("IMAGE_PROXY_ALLOWED_HOSTS", "www.foo.com")
+	_ = os.Setenv("IMAGE_PROXY_REWRITE_URLS", "true")
+	_ = os.Setenv("IMAGE_PROXY_BASE_URL", "https://bar.com/")
+	config.Load()
+	defer func() {
+		for k, v := range env {
+			_ = os.Setenv(k, v)
+		}
+		config.Load()
+	}()
+
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "651")
+	cr := domain.ContentListResponse{
+		Contents: []domain.Content{
+			{ImageURL: "http://foo.com/mangas/bleach/651/bleach 01.jpg", Page: 1},
+			{ImageURL: "http://baz.com/mangas/bleach/651/bleach 02.jpg", Page: 2},
+			{ImageURL: "http://WWW.foo.com/mangas/bleach/651/bleach 03.jpg", Page: 3},
+		},
+	}
+
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
+	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.Content{
+		{ImageURL: "https://bar.com/mangindo/v1/images/foo.com/mangas/bleach/651/bleach%2001.jpg", Page: 1},
+		{ImageURL: "http://baz.com/mangas/bleach/651/bleach%2002.jpg", Page: 2},
+		{ImageURL: "https://bar.com/mangindo/v1/images/www.foo.com/mangas/bleach/651/bleach%2003.jpg", Page: 3},
+	}, *cl)
 
 	s.cc.AssertExpectations(s.T())
 	s.ws.AssertExpectations(s.T())
 }
 
+func (s *ContentServiceTestSuite) TestGetContents_ReturnsImageMeta_WhenPagesAreProbed() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "652")
+	cr := domain.ContentListResponse{
+		Contents: []domain.Content{
+			{ImageURL: "http://foo.com/1.jpg", Page: 1},
+			{ImageURL: "http://foo.com/2.jpg", Page: 2},
+		},
+	}
+	cm, _ := json.Marshal(domain.ContentMetaResponse{
+		Images: map[string]domain.ImageMeta{"http://foo.com/1.jpg": {Width: 800, Height: 1200, Size: 123456}},
+	})
+	_ = s.cmca.Set(context.Background(), "bleach", "652", string(cm))
+	defer func() {
+		_ = s.cmca.Delete(context.Background(), "bleach", "652")
+	}()
+
+	s.cc.On("GetContentList", context.Background(), req.TitleID, req.Chapter).Return(&cr, nil)
+	s.ws.On("SetContentCache", req.TitleID, req.Chapter).Return(nil)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	cl, err := cs.GetContents(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.Content{
+		{ImageURL: "http://foo.com/1.jpg", Page: 1, Width: 800, Height: 1200, Size: 123456},
+		{ImageURL: "http://foo.com/2.jpg", Page: 2},
+	}, *cl)
+
+	s.cc.AssertExpectations(s.T())
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *ContentServiceTestSuite) TestGetNavigation_ReturnsNoChapters_WhenChapterCacheMisses() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "650")
+	s.ws.On("SetChapterCache", req.TitleID).Return(nil)
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	prev, next := cs.GetNavigation(context.Background(), req)
+
+	assert.Nil(s.T(), prev)
+	assert.Nil(s.T(), next)
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *ContentServiceTestSuite) TestGetNavigation_ReturnsAdjacentChapters_WhenChapterCacheHits() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "657")
+	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{
+		{Number: "658", Title: "Bleach 658", TitleID: "bleach"},
+		{Number: "657.5", Title: "Bleach 657.5", TitleID: "bleach"},
+		{Number: "657", Title: "Bleach 657", TitleID: "bleach"},
+		{Number: "656", Title: "Bleach 656", TitleID: "bleach"},
+		{Number: "655", Title: "Bleach 655", TitleID: "bleach"},
+	}}
+	cb, _ := json.Marshal(cr)
+	_ = s.chca.Set(context.Background(), req.TitleID, string(cb), config.CacheTTLs().Chapter)
+	defer func() {
+		_ = s.chca.Delete(context.Background(), req.TitleID)
+	}()
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	prev, next := cs.GetNavigation(context.Background(), req)
+
+	assert.Equal(s.T(), &contract.Chapter{Number: "656", Title: "Bleach 656", TitleID: "bleach"}, prev)
+	assert.Equal(s.T(), &contract.Chapter{Number: "657.5", Title: "Bleach 657.5", TitleID: "bleach"}, next)
+	s.ws.AssertNotCalled(s.T(), "SetChapterCache", req.TitleID)
+}
+
+func (s *ContentServiceTestSuite) TestGetNavigation_ReturnsNoNextChapter_WhenChapterIsTheLatest() {
+	ccm := manager.NewContentCacheManager(s.cc, s.cca)
+
+	req := contract.NewContentRequest("bleach", "657.5")
+	cr := domain.ChapterListResponse{Chapters: []domain.Chapter{
+		{Number: "657.5", Title: "Bleach 657.5", TitleID: "bleach"},
+		{Number: "657", Title: "Bleach 657", TitleID: "bleach"},
+	}}
+	cb, _ := json.Marshal(cr)
+	_ = s.chca.Set(context.Background(), req.TitleID, string(cb), config.CacheTTLs().Chapter)
+	defer func() {
+		_ = s.chca.Delete(context.Background(), req.TitleID)
+	}()
+
+	cs := NewContentService(s.cc, ccm, s.cmm, s.chcm, s.ws)
+	prev, next := cs.GetNavigation(context.Background(), req)
+
+	assert.Equal(s.T(), "657", prev.Number)
+	assert.Nil(s.T(), next)
+}
+
 func getFakeContent(page int) domain.Content {
 	return domain.Content{
 		ImageURL: "http://foo.com/foo.jpg",
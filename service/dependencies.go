@@ -5,62 +5,93 @@ import (
 	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
 	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/logger"
 )
 
 type Dependencies struct {
 	MangaService   MangaService
 	ChapterService ChapterService
 	ContentService ContentService
+	SearchService  SearchService
+	ImageService   ImageService
+
+	BatchContentService BatchContentService
+	DiagnosticsService  DiagnosticsService
 }
 
 type WorkerDependencies struct {
-	MangaCacheManager   manager.MangaCacheManager
-	ChapterCacheManager manager.ChapterCacheManager
-	ContentCacheManager manager.ContentCacheManager
+	MangaCacheManager       manager.MangaCacheManager
+	ChapterCacheManager     manager.ChapterCacheManager
+	ContentCacheManager     manager.ContentCacheManager
+	ContentMetaCacheManager manager.ContentMetaCacheManager
+	SearchIndexCacheManager manager.SearchIndexCacheManager
+	WorkerService           WorkerService
+}
+
+func newOriginSource() client.Source {
+	src, err := client.NewSource(config.OriginSource())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	return src
 }
 
 func InstantiateDependencies() Dependencies {
-	macl := client.NewMangaClient()
-	chcl := client.NewChapterClient()
-	cocl := client.NewContentClient()
+	src := newOriginSource()
 
 	maca := cache.NewMangaCache()
 	chca := cache.NewChapterCache()
 	coca := cache.NewContentCache()
+	sica := cache.NewSearchIndexCache()
 
-	macm := manager.NewMangaCacheManager(macl, maca)
-	chcm := manager.NewChapterCacheManager(chcl, chca)
-	cocm := manager.NewContentCacheManager(cocl, coca)
+	macm := manager.NewMangaCacheManager(src, maca)
+	chcm := manager.NewChapterCacheManager(src, macm, chca)
+	cocm := manager.NewContentCacheManager(src, coca)
+	cmcm := manager.NewContentMetaCacheManager(client.NewImageClient(), cocm, cache.NewContentMetaCache())
+	sicm := manager.NewSearchIndexCacheManager(macm, sica)
 
 	ws := NewWorkerService(appcontext.GetWorkerAdapter())
 
-	mas := NewMangaService(macl, macm, ws)
-	chs := NewChapterService(chcl, chcm, ws)
-	cos := NewContentService(cocl, cocm, ws)
+	chs := NewChapterService(src, chcm, ws)
+	mas := NewMangaService(src, macm, chs, ws)
+	cos := NewContentService(src, cocm, cmcm, chcm, ws)
+	ses := NewSearchService(src, macm, sicm, ws)
+	bcs := NewBatchContentService(chs, cos)
+	ims := NewImageService(client.NewImageClient(), cache.NewImageCache())
 
 	return Dependencies{
 		MangaService:   mas,
 		ChapterService: chs,
 		ContentService: cos,
+		SearchService:  ses,
+		ImageService:   ims,
+
+		BatchContentService: bcs,
+		DiagnosticsService:  NewDiagnosticsService(),
 	}
 }
 
 func InstantiateWorkerDependencies() WorkerDependencies {
-	mangaClient := client.NewMangaClient()
-	chapterClient := client.NewChapterClient()
-	contentClient := client.NewContentClient()
+	source := newOriginSource()
 
 	mangaCache := cache.NewMangaCache()
 	chapterCache := cache.NewChapterCache()
 	contentCache := cache.NewContentCache()
+	searchIndexCache := cache.NewSearchIndexCache()
 
-	mangaCacheManager := manager.NewMangaCacheManager(mangaClient, mangaCache)
-	chapterCacheManager := manager.NewChapterCacheManager(chapterClient, chapterCache)
-	contentCacheManager := manager.NewContentCacheManager(contentClient, contentCache)
+	mangaCacheManager := manager.NewMangaCacheManager(source, mangaCache)
+	chapterCacheManager := manager.NewChapterCacheManager(source, mangaCacheManager, chapterCache)
+	contentCacheManager := manager.NewContentCacheManager(source, contentCache)
+	contentMetaCacheManager := manager.NewContentMetaCacheManager(client.NewImageClient(), contentCacheManager, cache.NewContentMetaCache())
+	searchIndexCacheManager := manager.NewSearchIndexCacheManager(mangaCacheManager, searchIndexCache)
 
 	return WorkerDependencies{
-		MangaCacheManager:   mangaCacheManager,
-		ChapterCacheManager: chapterCacheManager,
-		ContentCacheManager: contentCacheManager,
+		MangaCacheManager:       mangaCacheManager,
+		ChapterCacheManager:     chapterCacheManager,
+		ContentCacheManager:     contentCacheManager,
+		ContentMetaCacheManager: contentMetaCacheManager,
+		SearchIndexCacheManager: searchIndexCacheManager,
+		WorkerService:           NewWorkerService(appcontext.GetWorkerAdapter()),
 	}
 }
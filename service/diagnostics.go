@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+type DiagnosticsService interface {
+	GetSchemaReports(ctx context.Context) ([]contract.SchemaReport, error)
+	GetRateLimitReports(ctx context.Context) ([]contract.RateLimitReport, error)
+}
+
+type diagnosticsService struct {
+	getReports          func(ctx context.Context) ([]client.SchemaReport, error)
+	getRateLimitReports func(ctx context.Context) ([]client.RateLimitReport, error)
+}
+
+func (s *diagnosticsService) GetSchemaReports(ctx context.Context) ([]contract.SchemaReport, error) {
+	rs, err := s.getReports(ctx)
+	if err != nil {
+		logger.Errorf("Failed to get schema reports, with error: %s", err.Error())
+		return nil, mErr.NewGenericError()
+	}
+
+	reports := []contract.SchemaReport{}
+	for _, r := range rs {
+		report := contract.SchemaReport{
+			Endpoint:         r.Endpoint,
+			HasDrift:         r.HasDrift(),
+			UnknownFields:    r.UnknownFields,
+			MissingFields:    r.MissingFields,
+			MismatchedFields: r.MismatchedFields,
+			CheckedAt:        r.CheckedAt,
+		}
+		if !r.DriftDetectedAt.IsZero() {
+			t := r.DriftDetectedAt
+			report.DriftDetectedAt = &t
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (s *diagnosticsService) GetRateLimitReports(ctx context.Context) ([]contract.RateLimitReport, error) {
+	rs, err := s.getRateLimitReports(ctx)
+	if err != nil {
+		logger.Errorf("Failed to get rate limit reports, with error: %s", err.Error())
+		return nil, mErr.NewGenericError()
+	}
+
+	reports := []contract.RateLimitReport{}
+	for _, r := range rs {
+		reports = append(reports, contract.RateLimitReport{
+			Endpoint:    r.Endpoint,
+			Throttled:   r.Throttled,
+			Rejected:    r.Rejected,
+			TotalWaitMs: int64(r.WaitTime / time.Millisecond),
+		})
+	}
+	return reports, nil
+}
+
+func NewDiagnosticsService() *diagnosticsService {
+	return &diagnosticsService{
+		getReports:          client.GetSchemaReports,
+		getRateLimitReports: client.GetRateLimitReports,
+	}
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiagnosticsServiceTestSuite struct {
+	suite.Suite
+}
+
+func TestDiagnosticsServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DiagnosticsServiceTestSuite))
+}
+
+func (s *DiagnosticsServiceTestSuite) SetupSuite() {
+	logger.SetupLogger()
+}
+
+func (s *DiagnosticsServiceTestSuite) TestGetSchemaReports_ReturnsMappedReports() {
+	now := time.Now().UTC()
+	ds := &diagnosticsService{
+		getReports: func(ctx context.Context) ([]client.SchemaReport, error) {
+			return []client.SchemaReport{
+				{
+					Endpoint:         "foo",
+					UnknownFields:    map[string]int{"komik[].jenis": 2},
+					MissingFields:    map[string]int{},
+					MismatchedFields: map[string]string{},
+					CheckedAt:        now,
+					DriftDetectedAt:  now,
+				},
+				{Endpoint: "bar", CheckedAt: now},
+			}, nil
+		},
+	}
+
+	reports, err := ds.GetSchemaReports(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.SchemaReport{
+		{
+			Endpoint:         "foo",
+			HasDrift:         true,
+			UnknownFields:    map[string]int{"komik[].jenis": 2},
+			MissingFields:    map[string]int{},
+			MismatchedFields: map[string]string{},
+			CheckedAt:        now,
+			DriftDetectedAt:  &now,
+		},
+		{Endpoint: "bar", CheckedAt: now},
+	}, reports)
+}
+
+func (s *DiagnosticsServiceTestSuite) TestGetSchemaReports_ReturnsError_WhenReportsCanNotBeRead() {
+	ds := &diagnosticsService{
+		getReports: func(ctx context.Context) ([]client.SchemaReport, error) {
+			return nil, errors.New("some error")
+		},
+	}
+
+	reports, err := ds.GetSchemaReports(context.Background())
+
+	assert.Nil(s.T(), reports)
+	assert.Equal(s.T(), mErr.NewGenericError(), err)
+}
+
+func (s *DiagnosticsServiceTestSuite) TestGetRateLimitReports_ReturnsMappedReports() {
+	ds := &diagnosticsService{
+		getRateLimitReports: func(ctx context.Context) ([]client.RateLimitReport, error) {
+			return []client.RateLimitReport{
+				{Endpoint: "foo", Throttled: 3, Rejected: 1, WaitTime: 1500 * time.Millisecond},
+			}, nil
+		},
+	}
+
+	reports, err := ds.GetRateLimitReports(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.RateLimitReport{
+		{Endpoint: "foo", Throttled: 3, Rejected: 1, TotalWaitMs: 1500},
+	}, reports)
+}
+
+func (s *DiagnosticsServiceTestSuite) TestGetRateLimitReports_ReturnsError_WhenReportsCanNotBeRead() {
+	ds := &diagnosticsService{
+		getRateLimitReports: func(ctx context.Context) ([]client.RateLimitReport, error) {
+			return nil, errors.New("some error")
+		},
+	}
+
+	reports, err := ds.GetRateLimitReports(context.Background())
+
+	assert.Nil(s.T(), reports)
+	assert.Equal(s.T(), mErr.NewGenericError(), err)
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var errFlightAborted = errors.New("coalesced call aborted")
+
+// originFlights coalesces the origin fetches made on cache misses, so a burst
+// of requests for the same uncached title or chapter makes one origin call.
+var originFlights = newFlightGroup()
+
+// flightGroup runs one call per key at a time. Callers arriving while a call
+// is running wait for it and share its result.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done      chan struct{}
+	val       interface{}
+	err       error
+	cancelled bool
+}
+
+// do returns the result of fn, or of the running call of key. A waiting
+// caller whose context is still alive makes its own call when the caller that
+// ran the shared one was cancelled.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() (interface{}, error)) (interface{}, error) {
+	for {
+		g.mu.Lock()
+		f, ok := g.calls[key]
+		if !ok {
+			f = &flight{done: make(chan struct{}), err: errFlightAborted}
+			g.calls[key] = f
+			g.mu.Unlock()
+
+			g.run(ctx, key, f, fn)
+			return f.val, f.err
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.done:
+		}
+
+		if f.cancelled && ctx.Err() == nil {
+			continue
+		}
+		return f.val, f.err
+	}
+}
+
+func (g *flightGroup) run(ctx context.Context, key string, f *flight, fn func() (interface{}, error)) {
+	defer func() {
+		f.cancelled = ctx.Err() != nil
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(f.done)
+	}()
+
+	f.val, f.err = fn()
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{
+		calls: map[string]*flight{},
+	}
+}
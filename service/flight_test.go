@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type FlightGroupTestSuite struct {
+	suite.Suite
+}
+
+func TestFlightGroupTestSuite(t *testing.T) {
+	suite.Run(t, new(FlightGroupTestSuite))
+}
+
+func (s *FlightGroupTestSuite) TestDo_SharesOneCall_WhenCallsOverlap() {
+	g := newFlightGroup()
+	var mu sync.Mutex
+	calls := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := g.do(context.Background(), "foo", func() (interface{}, error) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+				time.Sleep(50 * time.Millisecond)
+				return "bar", nil
+			})
+
+			assert.Nil(s.T(), err)
+			assert.Equal(s.T(), "bar", v)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(s.T(), 1, calls)
+}
+
+func (s *FlightGroupTestSuite) TestDo_CallsAgain_WhenPreviousCallIsDone() {
+	g := newFlightGroup()
+	calls := 0
+	fn := func() (interface{}, error) {
+		calls++
+		return nil, errors.New("some error")
+	}
+
+	_, _ = g.do(context.Background(), "foo", fn)
+	_, err := g.do(context.Background(), "foo", fn)
+
+	assert.Equal(s.T(), "some error", err.Error())
+	assert.Equal(s.T(), 2, calls)
+}
+
+func (s *FlightGroupTestSuite) TestDo_CallsAgain_WhenSharedCallerWasCancelled() {
+	g := newFlightGroup()
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+
+	go func() {
+		_, _ = g.do(ctx, "foo", func() (interface{}, error) {
+			close(started)
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+			return nil, context.Canceled
+		})
+	}()
+	<-started
+
+	v, err := g.do(context.Background(), "foo", func() (interface{}, error) {
+		return "bar", nil
+	})
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "bar", v)
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+)
+
+type ImageService interface {
+	GetImage(ctx context.Context, path string) (*contract.Image, error)
+}
+
+type imageService struct {
+	imageClient client.ImageClient
+	imageCache  cache.ImageCache
+	maxAge      time.Duration
+}
+
+// isImageHostAllowed tells whether images on host are served through the
+// proxy, which are the ones on the origin and the configured image hosts.
+func isImageHostAllowed(host string) bool {
+	for _, h := range config.ImageProxy().AllowedHosts {
+		if strings.EqualFold(host, h) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitImagePath returns the image host a proxied path starts with, and the
+// path on it. Paths without an allowed host, like the ones rewritten before
+// hosts were added to them, are looked up on the origin.
+func splitImagePath(imagePath string) (host string, p string) {
+	parts := strings.SplitN(imagePath, "/", 2)
+	if len(parts) == 2 && isImageHostAllowed(parts[0]) {
+		return strings.ToLower(parts[0]), parts[1]
+	}
+
+	if origin, err := url.Parse(config.BaseURL()); err == nil {
+		host = origin.Host
+	}
+	return host, imagePath
+}
+
+// GetImage serves an image from the disk cache, or streams it from its host
+// while caching it. Only cached images are seekable, so byte ranges are
+// served once an image has been fetched once.
+func (s *imageService) GetImage(ctx context.Context, imagePath string) (*contract.Image, error) {
+	if f, err := s.imageCache.Get(ctx, imagePath); err == nil {
+		fi, err := f.Stat()
+		if err == nil {
+			return &contract.Image{
+				Name:       path.Base(imagePath),
+				ModifiedAt: fi.ModTime(),
+				Content:    f,
+				MaxAge:     s.maxAge,
+			}, nil
+		}
+		f.Close()
+	}
+
+	host, p := splitImagePath(imagePath)
+	body, err := s.imageClient.GetImage(ctx, host, p)
+	if err != nil {
+		if se, ok := err.(*mErr.OriginStatusError); ok && se.StatusCode == http.StatusNotFound {
+			return nil, mErr.NewNotFoundError("image")
+		}
+		return nil, getOriginFetchError(err)
+	}
+
+	return &contract.Image{
+		Name:       path.Base(imagePath),
+		ModifiedAt: time.Now(),
+		Content:    s.newCachingReader(ctx, imagePath, body),
+		MaxAge:     s.maxAge,
+	}, nil
+}
+
+// cachingReader passes an origin image through while writing it to the
+// cache. The cached copy is only kept once the whole image was read, so
+// failed or abandoned downloads are dropped.
+type cachingReader struct {
+	path string
+	body io.ReadCloser
+	w    cache.ImageWriter
+}
+
+func (s *imageService) newCachingReader(ctx context.Context, imagePath string, body io.ReadCloser) *cachingReader {
+	w, err := s.imageCache.Create(ctx, imagePath)
+	if err != nil {
+		logger.Errorf("Failed to cache image %s, with error: %s", imagePath, err.Error())
+		w = nil
+	}
+	return &cachingReader{path: imagePath, body: body, w: w}
+}
+
+func (r *cachingReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	if r.w == nil {
+		return n, err
+	}
+
+	if n > 0 {
+		if _, werr := r.w.Write(p[:n]); werr != nil {
+			logger.Errorf("Failed to cache image %s, with error: %s", r.path, werr.Error())
+			_ = r.w.Close()
+			r.w = nil
+			return n, err
+		}
+	}
+	if err == io.EOF {
+		if cerr := r.w.Commit(); cerr != nil {
+			logger.Errorf("Failed to cache image %s, with error: %s", r.path, cerr.Error())
+		}
+		r.w = nil
+	}
+	return n, err
+}
+
+func (r *cachingReader) Close() error {
+	if r.w != nil {
+		_ = r.w.Close()
+		r.w = nil
+	}
+	return r.body.Close()
+}
+
+func NewImageService(ic client.ImageClient, ica cache.ImageCache) *imageService {
+	return &imageService{
+		imageClient: ic,
+		imageCache:  ica,
+		maxAge:      config.ImageProxy().CacheTTL,
+	}
+}
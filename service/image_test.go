@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/config"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ImageServiceTestSuite struct {
+	suite.Suite
+	dir string
+	ic  *mock.ImageClientMock
+	ica cache.ImageCache
+}
+
+func TestImageServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ImageServiceTestSuite))
+}
+
+func (s *ImageServiceTestSuite) SetupSuite() {
+	logger.SetupLogger()
+}
+
+func (s *ImageServiceTestSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "image-service")
+	s.Require().NoError(err)
+	s.dir = dir
+
+	_ = os.Setenv("IMAGE_CACHE_DIR", dir)
+	config.Load()
+
+	s.ic = &mock.ImageClientMock{}
+	s.ica = cache.NewImageCache()
+}
+
+func (s *ImageServiceTestSuite) TearDownTest() {
+	_ = os.Unsetenv("IMAGE_CACHE_DIR")
+	config.Load()
+	_ = os.RemoveAll(s.dir)
+}
+
+const fakeImagePath = "mangas/bleach/686/01.jpg"
+
+func getOriginHost() string {
+	u, _ := url.Parse(config.BaseURL())
+	return u.Host
+}
+
+func getFakeImageBody(data string) io.ReadCloser {
+	return ioutil.NopCloser(strings.NewReader(data))
+}
+
+// failingBody returns its data, then fails like a dropped origin connection.
+type failingBody struct {
+	io.Reader
+}
+
+func (b failingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		return n, errors.New("connection reset")
+	}
+	return n, err
+}
+
+func (failingBody) Close() error {
+	return nil
+}
+
+func readImage(s *ImageServiceTestSuite, svc ImageService) ([]byte, bool, error) {
+	image, err := svc.GetImage(context.Background(), fakeImagePath)
+	s.Require().NoError(err)
+	if c, ok := image.Content.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	assert.Equal(s.T(), "01.jpg", image.Name)
+	assert.Equal(s.T(), config.ImageProxy().CacheTTL, image.MaxAge)
+
+	_, seekable := image.Content.(io.ReadSeeker)
+	b, err := ioutil.ReadAll(image.Content)
+	return b, seekable, err
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_StreamsOnceAndServesFromCache() {
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(getFakeImageBody("foo"), nil).Once()
+
+	svc := NewImageService(s.ic, s.ica)
+
+	b, seekable, err := readImage(s, svc)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "foo", string(b))
+	assert.False(s.T(), seekable)
+
+	b, seekable, err = readImage(s, svc)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "foo", string(b))
+	assert.True(s.T(), seekable)
+	s.ic.AssertExpectations(s.T())
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_DoesNotCacheImage_WhenStreamFails() {
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(failingBody{strings.NewReader("fo")}, nil).Once()
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(getFakeImageBody("foo"), nil).Once()
+
+	svc := NewImageService(s.ic, s.ica)
+
+	_, _, err := readImage(s, svc)
+	assert.Equal(s.T(), "connection reset", err.Error())
+
+	b, seekable, err := readImage(s, svc)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "foo", string(b))
+	assert.False(s.T(), seekable)
+	s.ic.AssertExpectations(s.T())
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_ReturnsNotFound_WhenOriginReturns404() {
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(nil, mErr.NewOriginStatusError(http.StatusNotFound))
+
+	image, err := NewImageService(s.ic, s.ica).GetImage(context.Background(), fakeImagePath)
+
+	assert.Nil(s.T(), image)
+	assert.Equal(s.T(), mErr.NewNotFoundError("image"), err)
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_ReturnsOriginError_WhenOriginIsUnavailable() {
+	originErr := mErr.NewOriginUnavailableError("foo")
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(nil, originErr)
+
+	image, err := NewImageService(s.ic, s.ica).GetImage(context.Background(), fakeImagePath)
+
+	assert.Nil(s.T(), image)
+	assert.Equal(s.T(), originErr, err)
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_ReturnsGenericError_WhenClientFails() {
+	s.ic.On("GetImage", context.Background(), getOriginHost(), fakeImagePath).Return(nil, errors.New("foo"))
+
+	image, err := NewImageService(s.ic, s.ica).GetImage(context.Background(), fakeImagePath)
+
+	assert.Nil(s.T(), image)
+	assert.Equal(s.T(), mErr.NewGenericError(), err)
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_FetchesFromImageHost_WhenPathStartsWithAllowedHost() {
+	_ = os.Setenv("IMAGE_PROXY_ALLOWED_HOSTS", "www.foo.com")
+	config.Load()
+	defer os.Unsetenv("IMAGE_PROXY_ALLOWED_HOSTS")
+
+	s.ic.On("GetImage", context.Background(), "www.foo.com", fakeImagePath).Return(getFakeImageBody("foo"), nil)
+
+	image, err := NewImageService(s.ic, s.ica).GetImage(context.Background(), "WWW.foo.com/"+fakeImagePath)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "01.jpg", image.Name)
+	s.ic.AssertExpectations(s.T())
+}
+
+func (s *ImageServiceTestSuite) TestGetImage_FetchesFromOrigin_WhenPathStartsWithUnknownHost() {
+	s.ic.On("GetImage", context.Background(), getOriginHost(), "evil.com/"+fakeImagePath).Return(nil, mErr.NewOriginStatusError(http.StatusNotFound))
+
+	image, err := NewImageService(s.ic, s.ica).GetImage(context.Background(), "evil.com/"+fakeImagePath)
+
+	assert.Nil(s.T(), image)
+	assert.Equal(s.T(), mErr.NewNotFoundError("image"), err)
+	s.ic.AssertExpectations(s.T())
+}
@@ -1,8 +1,12 @@
 package service
 
 import (
+	"context"
+	"sort"
+
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
 	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/contract"
@@ -12,12 +16,15 @@ import (
 )
 
 type MangaService interface {
-	GetMangas() (popular *[]contract.Manga, latest *[]contract.Manga, err error)
+	GetMangas(ctx context.Context, req contract.MangaListRequest) (popular *[]contract.Manga, latest *[]contract.Manga, err error)
+	GetManga(ctx context.Context, req contract.MangaRequest) (*contract.MangaDetail, error)
+	GetGenres(ctx context.Context) (*[]contract.Genre, error)
 }
 
 type mangaService struct {
 	mangaClient       client.MangaClient
 	mangaCacheManager manager.MangaCacheManager
+	chapterService    ChapterService
 	workerService     WorkerService
 }
 
@@ -28,35 +35,84 @@ func getMappedManga(dm domain.Manga) contract.Manga {
 		IconURL:     dm.IconURL,
 		LastChapter: dm.LastChapter,
 		Genre:       dm.Genre,
+		Genres:      common.ParseGenres(dm.Genre),
 		Alias:       dm.Alias,
 		Author:      dm.Author,
 		Status:      dm.Status,
 		PublishYear: dm.PublishYear,
 		Summary:     dm.Summary,
+
+		ModifiedDate: dm.ModifiedDate,
 	}
 }
 
-func isPopularManga(titleID string) bool {
-	for _, tag := range config.PopularMangaTags() {
-		if titleID == tag {
+func hasGenres(manga contract.Manga, genres []string, match string) bool {
+	if len(genres) == 0 {
+		return true
+	}
+
+	owned := map[string]bool{}
+	for _, g := range manga.Genres {
+		owned[g] = true
+	}
+
+	for _, g := range genres {
+		if owned[g] && match == constants.GenreMatchAny {
 			return true
 		}
+		if !owned[g] && match == constants.GenreMatchAll {
+			return false
+		}
 	}
-	return false
+	return match == constants.GenreMatchAll
 }
 
-func (s *mangaService) GetMangas() (popular *[]contract.Manga, latest *[]contract.Manga, err error) {
-	ml, err := s.mangaCacheManager.GetCache()
-	if err != nil {
-		ml, err = s.mangaClient.GetMangaList()
-		if err != nil {
-			return nil, nil, mErr.NewGenericError()
+func getMangaList(ctx context.Context, mc client.MangaClient, mcm manager.MangaCacheManager, ws WorkerService) (*domain.MangaListResponse, error) {
+	ml, f, err := mcm.GetCacheEntry(ctx)
+	if err == nil {
+		common.RecordCacheAge(ctx, f.Age, f.Stale)
+		if f.Refresh {
+			enqueueSetMangaCache(ws)
 		}
+		return ml, nil
+	}
 
-		err = s.workerService.SetMangaCache()
+	v, err := originFlights.do(ctx, "MangaList", func() (interface{}, error) {
+		ml, err := mc.GetMangaList(ctx)
 		if err != nil {
-			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetMangaCacheJob, err.Error())
+			return nil, err
 		}
+
+		enqueueSetMangaCache(ws)
+		return ml, nil
+	})
+	if err != nil {
+		return nil, getOriginFetchError(err)
+	}
+	return v.(*domain.MangaListResponse), nil
+}
+
+func enqueueSetMangaCache(ws WorkerService) {
+	err := ws.SetMangaCache()
+	if err != nil {
+		logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetMangaCacheJob, err.Error())
+	}
+}
+
+// getOriginFetchError keeps the origin errors and rate limit rejections,
+// which handlers answer with 502 and 503, and hides any other failure behind
+// a generic error.
+func getOriginFetchError(err error) error {
+	if _, ok := err.(*mErr.RateLimitedError); ok || mErr.IsOriginError(err) {
+		return err
+	}
+	return mErr.NewGenericError()
+}
+
+func (s *mangaService) GetMangas(ctx context.Context, req contract.MangaListRequest) (popular *[]contract.Manga, latest *[]contract.Manga, err error) {
+	ml, err := getMangaList(ctx, s.mangaClient, s.mangaCacheManager, s.workerService)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if len(ml.Mangas) == 0 {
@@ -67,13 +123,20 @@ func (s *mangaService) GetMangas() (popular *[]contract.Manga, latest *[]contrac
 	var lMangas []contract.Manga
 	for _, dm := range ml.Mangas {
 		manga := getMappedManga(dm)
-		if isPopularManga(manga.TitleID) {
+		if !hasGenres(manga, req.Genres, req.GenreMatch) {
+			continue
+		}
+		if config.IsPopularManga(manga.TitleID) {
 			pMangas = append(pMangas, manga)
 		} else {
 			lMangas = append(lMangas, manga)
 		}
 	}
 
+	if len(pMangas) == 0 && len(lMangas) == 0 {
+		return nil, nil, nil
+	}
+
 	if len(pMangas) == 0 {
 		return nil, &lMangas, nil
 	}
@@ -85,10 +148,75 @@ func (s *mangaService) GetMangas() (popular *[]contract.Manga, latest *[]contrac
 	return &pMangas, &lMangas, nil
 }
 
-func NewMangaService(mc client.MangaClient, mcm manager.MangaCacheManager, ws WorkerService) *mangaService {
+func (s *mangaService) GetManga(ctx context.Context, req contract.MangaRequest) (*contract.MangaDetail, error) {
+	ml, err := getMangaList(ctx, s.mangaClient, s.mangaCacheManager, s.workerService)
+	if err != nil {
+		return nil, err
+	}
+
+	var dm *domain.Manga
+	for i := range ml.Mangas {
+		if ml.Mangas[i].TitleID == req.TitleID {
+			dm = &ml.Mangas[i]
+			break
+		}
+	}
+
+	if dm == nil {
+		return nil, mErr.NewNotFoundError("manga")
+	}
+
+	detail := contract.MangaDetail{Manga: getMappedManga(*dm)}
+
+	cr := contract.NewChapterRequest(req.TitleID)
+	cr.Limit = 1
+	chapters, total, err := s.chapterService.GetChapters(ctx, cr)
+	if err != nil {
+		logger.Errorf("Failed to get chapters of %s, with error: %s", req.TitleID, err.Error())
+		return &detail, nil
+	}
+
+	detail.ChapterCount = total
+	if len(*chapters) > 0 {
+		detail.NewestChapter = &(*chapters)[0]
+	}
+
+	return &detail, nil
+}
+
+func (s *mangaService) GetGenres(ctx context.Context) (*[]contract.Genre, error) {
+	ml, err := getMangaList(ctx, s.mangaClient, s.mangaCacheManager, s.workerService)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, dm := range ml.Mangas {
+		for _, g := range common.ParseGenres(dm.Genre) {
+			counts[g]++
+		}
+	}
+
+	if len(counts) == 0 {
+		return nil, mErr.NewNotFoundError("genre")
+	}
+
+	genres := []contract.Genre{}
+	for name, count := range counts {
+		genres = append(genres, contract.Genre{Name: name, MangaCount: count})
+	}
+	sort.Slice(genres, func(i, j int) bool {
+		return genres[i].Name < genres[j].Name
+	})
+
+	return &genres, nil
+}
+
+func NewMangaService(mc client.MangaClient, mcm manager.MangaCacheManager, cs ChapterService, ws WorkerService) *mangaService {
 	return &mangaService{
 		mangaClient:       mc,
 		mangaCacheManager: mcm,
+		chapterService:    cs,
 		workerService:     ws,
 	}
 }
@@ -1,14 +1,18 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/cache/manager"
+	"github.com/bigscreen/mangindo-feeder/common"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/contract"
 	"github.com/bigscreen/mangindo-feeder/domain"
@@ -23,6 +27,7 @@ type MangaServiceTestSuite struct {
 	suite.Suite
 	mca cache.MangaCache
 	mc  *mock.MangaClientMock
+	cs  *mock.ChapterServiceMock
 	ws  *mock.WorkerServiceMock
 }
 
@@ -39,16 +44,17 @@ func (s *MangaServiceTestSuite) SetupSuite() {
 func (s *MangaServiceTestSuite) SetupTest() {
 	s.mca = cache.NewMangaCache()
 	s.mc = &mock.MangaClientMock{}
+	s.cs = &mock.ChapterServiceMock{}
 	s.ws = &mock.WorkerServiceMock{}
 }
 
 func (s *MangaServiceTestSuite) TestGetMangas_ReturnsError_WhenCacheMissesAndClientReturnsError() {
 	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
 
-	s.mc.On("GetMangaList").Return(nil, errors.New("some error"))
+	s.mc.On("GetMangaList", context.Background()).Return(nil, errors.New("some error"))
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	assert.Nil(s.T(), pMangas)
 	assert.Nil(s.T(), lMangas)
@@ -58,24 +64,54 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsError_WhenCacheMissesAndCli
 	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
 }
 
+func (s *MangaServiceTestSuite) TestGetMangas_ReturnsOriginError_WhenCacheMissesAndOriginFails() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	originErr := mErr.NewInvalidOriginResponseError()
+	s.mc.On("GetMangaList", context.Background()).Return(nil, originErr)
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
+
+	assert.Nil(s.T(), pMangas)
+	assert.Nil(s.T(), lMangas)
+	assert.Equal(s.T(), originErr, err)
+	assert.Equal(s.T(), http.StatusBadGateway, mErr.GetStatusCodeOf(err))
+}
+
+func (s *MangaServiceTestSuite) TestGetMangas_ReturnsRateLimitedError_WhenOriginCallIsRejected() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	rateErr := mErr.NewRateLimitedError("GetMangaList")
+	s.mc.On("GetMangaList", context.Background()).Return(nil, rateErr)
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
+
+	assert.Nil(s.T(), pMangas)
+	assert.Nil(s.T(), lMangas)
+	assert.Equal(s.T(), rateErr, err)
+	assert.Equal(s.T(), http.StatusServiceUnavailable, mErr.GetStatusCodeOf(err))
+}
+
 func (s *MangaServiceTestSuite) TestGetMangas_ReturnsError_WhenCacheHitsAndMangaListIsEmpty() {
 	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
 
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{}}
 	cb, _ := json.Marshal(mr)
-	_ = s.mca.Set(string(cb))
+	_ = s.mca.Set(context.Background(), string(cb))
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	assert.Nil(s.T(), pMangas)
 	assert.Nil(s.T(), lMangas)
 	assert.Equal(s.T(), mErr.NewNotFoundError("manga").Error(), err.Error())
 
-	s.mc.AssertNotCalled(s.T(), "GetMangaList")
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
 	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
 }
 
@@ -83,11 +119,11 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsError_WhenCacheMissesAndMan
 	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
 
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{}}
-	s.mc.On("GetMangaList").Return(&mr, nil)
+	s.mc.On("GetMangaList", context.Background()).Return(&mr, nil)
 	s.ws.On("SetMangaCache").Return(nil)
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	assert.Nil(s.T(), pMangas)
 	assert.Nil(s.T(), lMangas)
@@ -104,9 +140,9 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsPopularMangas_WhenCacheHits
 	dm := getFakePopularManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
 	cb, _ := json.Marshal(mr)
-	_ = s.mca.Set(string(cb))
+	_ = s.mca.Set(context.Background(), string(cb))
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
 	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
@@ -116,8 +152,8 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsPopularMangas_WhenCacheHits
 		config.Load()
 	}()
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	fpManga := (*pMangas)[0]
 
@@ -126,10 +162,40 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsPopularMangas_WhenCacheHits
 	assert.True(s.T(), len(*pMangas) > 0)
 	assertMappedManga(s.T(), dm, fpManga)
 
-	s.mc.AssertNotCalled(s.T(), "GetMangaList")
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
 	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
 }
 
+func (s *MangaServiceTestSuite) TestGetMangas_ServesStaleCacheAndEnqueuesOneRefresh_WhenCacheIsStale() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakeLatestManga()}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	ageCacheEntry("MangasCache", 23*time.Hour, config.CacheTTLs().Manga)
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+		appcontext.GetRedisClient().Del("RefreshLock|MangasCache")
+	}()
+
+	s.ws.On("SetMangaCache").Return(nil).Once()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	for i := 0; i < 2; i++ {
+		ctx := common.WithCacheAge(context.Background())
+		_, lMangas, err := ms.GetMangas(ctx, contract.NewMangaListRequest(nil, ""))
+
+		age, stale := common.GetCacheAge(ctx)
+		assert.Nil(s.T(), err)
+		assert.True(s.T(), len(*lMangas) > 0)
+		assert.True(s.T(), stale)
+		assert.True(s.T(), age >= 23*time.Hour)
+	}
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.ws.AssertNumberOfCalls(s.T(), "SetMangaCache", 1)
+}
+
 func (s *MangaServiceTestSuite) TestGetMangas_ReturnsPopularMangas_WhenCacheMissesAndMangaListContainsOnlyPopularMangas() {
 	tags := os.Getenv("POPULAR_MANGA_TAGS")
 	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
@@ -143,11 +209,11 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsPopularMangas_WhenCacheMiss
 
 	dm := getFakePopularManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
-	s.mc.On("GetMangaList").Return(&mr, nil)
+	s.mc.On("GetMangaList", context.Background()).Return(&mr, nil)
 	s.ws.On("SetMangaCache").Return(nil)
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	fpManga := (*pMangas)[0]
 
@@ -167,9 +233,9 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsLatestMangas_WhenCacheHitsA
 	dm := getFakeLatestManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
 	cb, _ := json.Marshal(mr)
-	_ = s.mca.Set(string(cb))
+	_ = s.mca.Set(context.Background(), string(cb))
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
 	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
@@ -179,8 +245,8 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsLatestMangas_WhenCacheHitsA
 		config.Load()
 	}()
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	flManga := (*lMangas)[0]
 
@@ -189,7 +255,7 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsLatestMangas_WhenCacheHitsA
 	assert.True(s.T(), len(*lMangas) > 0)
 	assertMappedManga(s.T(), dm, flManga)
 
-	s.mc.AssertNotCalled(s.T(), "GetMangaList")
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
 	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
 }
 
@@ -206,11 +272,11 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsLatestMangas_WhenCacheMisse
 
 	dm := getFakeLatestManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
-	s.mc.On("GetMangaList").Return(&mr, nil)
+	s.mc.On("GetMangaList", context.Background()).Return(&mr, nil)
 	s.ws.On("SetMangaCache").Return(nil)
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	flManga := (*lMangas)[0]
 
@@ -231,9 +297,9 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsAllMangas_WhenCacheHits() {
 	dlm := getFakeLatestManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dpm, dlm}}
 	cb, _ := json.Marshal(mr)
-	_ = s.mca.Set(string(cb))
+	_ = s.mca.Set(context.Background(), string(cb))
 	defer func() {
-		_ = s.mca.Delete()
+		_ = s.mca.Delete(context.Background())
 	}()
 
 	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
@@ -243,8 +309,8 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsAllMangas_WhenCacheHits() {
 		config.Load()
 	}()
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	fpManga := (*pMangas)[0]
 	flManga := (*lMangas)[0]
@@ -255,7 +321,7 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsAllMangas_WhenCacheHits() {
 	assertMappedManga(s.T(), dpm, fpManga)
 	assertMappedManga(s.T(), dlm, flManga)
 
-	s.mc.AssertNotCalled(s.T(), "GetMangaList")
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
 	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
 }
 
@@ -273,11 +339,11 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsAllMangas_WhenCacheMisses()
 	dpm := getFakePopularManga()
 	dlm := getFakeLatestManga()
 	mr := domain.MangaListResponse{Mangas: []domain.Manga{dpm, dlm}}
-	s.mc.On("GetMangaList").Return(&mr, nil)
+	s.mc.On("GetMangaList", context.Background()).Return(&mr, nil)
 	s.ws.On("SetMangaCache").Return(nil)
 
-	ms := NewMangaService(s.mc, mcm, s.ws)
-	pMangas, lMangas, err := ms.GetMangas()
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest(nil, ""))
 
 	fpManga := (*pMangas)[0]
 	flManga := (*lMangas)[0]
@@ -292,6 +358,127 @@ func (s *MangaServiceTestSuite) TestGetMangas_ReturnsAllMangas_WhenCacheMisses()
 	s.ws.AssertExpectations(s.T())
 }
 
+func (s *MangaServiceTestSuite) TestGetManga_ReturnsError_WhenCacheMissesAndClientReturnsError() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	req := contract.NewMangaRequest("one_piece")
+	s.mc.On("GetMangaList", context.Background()).Return(nil, errors.New("some error"))
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	manga, err := ms.GetManga(context.Background(), req)
+
+	assert.Nil(s.T(), manga)
+	assert.Equal(s.T(), mErr.NewGenericError().Error(), err.Error())
+
+	s.mc.AssertExpectations(s.T())
+	s.cs.AssertNotCalled(s.T(), "GetChapters", context.Background(), getNewestChapterRequest(req.TitleID))
+	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
+}
+
+func (s *MangaServiceTestSuite) TestGetManga_ReturnsError_WhenCacheHitsAndMangaDoesNotExist() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakeLatestManga()}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	req := contract.NewMangaRequest("one_piece")
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	manga, err := ms.GetManga(context.Background(), req)
+
+	assert.Nil(s.T(), manga)
+	assert.Equal(s.T(), mErr.NewNotFoundError("manga").Error(), err.Error())
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.cs.AssertNotCalled(s.T(), "GetChapters", context.Background(), getNewestChapterRequest(req.TitleID))
+	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
+}
+
+func (s *MangaServiceTestSuite) TestGetManga_ReturnsMangaWithoutChapters_WhenChapterServiceReturnsError() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dm := getFakePopularManga()
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	req := contract.NewMangaRequest("one_piece")
+	s.cs.On("GetChapters", context.Background(), getNewestChapterRequest(req.TitleID)).
+		Return(nil, 0, mErr.NewNotFoundError("chapter"))
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	manga, err := ms.GetManga(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assertMappedManga(s.T(), dm, manga.Manga)
+	assert.Equal(s.T(), dm.ModifiedDate, manga.ModifiedDate)
+	assert.Equal(s.T(), 0, manga.ChapterCount)
+	assert.Nil(s.T(), manga.NewestChapter)
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.cs.AssertExpectations(s.T())
+}
+
+func (s *MangaServiceTestSuite) TestGetManga_ReturnsMangaWithChapters_WhenCacheHits() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dm := getFakePopularManga()
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakeLatestManga(), dm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	req := contract.NewMangaRequest("one_piece")
+	chapters := []contract.Chapter{{Number: "939.5", Title: "One Piece 939.5", TitleID: "one_piece"}}
+	s.cs.On("GetChapters", context.Background(), getNewestChapterRequest(req.TitleID)).Return(&chapters, 3, nil)
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	manga, err := ms.GetManga(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assertMappedManga(s.T(), dm, manga.Manga)
+	assert.Equal(s.T(), dm.ModifiedDate, manga.ModifiedDate)
+	assert.Equal(s.T(), 3, manga.ChapterCount)
+	assert.Equal(s.T(), chapters[0], *manga.NewestChapter)
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.ws.AssertNotCalled(s.T(), "SetMangaCache")
+	s.cs.AssertExpectations(s.T())
+}
+
+func (s *MangaServiceTestSuite) TestGetManga_ReturnsMangaWithChapters_WhenCacheMisses() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dm := getFakePopularManga()
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
+	s.mc.On("GetMangaList", context.Background()).Return(&mr, nil)
+	s.ws.On("SetMangaCache").Return(nil)
+
+	req := contract.NewMangaRequest("one_piece")
+	chapters := []contract.Chapter{{Number: "939", Title: "One Piece 939", TitleID: "one_piece"}}
+	s.cs.On("GetChapters", context.Background(), getNewestChapterRequest(req.TitleID)).Return(&chapters, 1, nil)
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	manga, err := ms.GetManga(context.Background(), req)
+
+	assert.Nil(s.T(), err)
+	assertMappedManga(s.T(), dm, manga.Manga)
+	assert.Equal(s.T(), 1, manga.ChapterCount)
+	assert.Equal(s.T(), chapters[0], *manga.NewestChapter)
+
+	s.mc.AssertExpectations(s.T())
+	s.ws.AssertExpectations(s.T())
+	s.cs.AssertExpectations(s.T())
+}
+
 func getFakePopularManga() domain.Manga {
 	return domain.Manga{
 		ID:           "23",
@@ -326,14 +513,164 @@ func getFakeLatestManga() domain.Manga {
 	}
 }
 
+func (s *MangaServiceTestSuite) TestGetMangas_ReturnsNoMangas_WhenNoMangaMatchesGenres() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakePopularManga(), getFakeLatestManga()}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest([]string{"horror"}, ""))
+
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), pMangas)
+	assert.Nil(s.T(), lMangas)
+}
+
+func (s *MangaServiceTestSuite) TestGetMangas_ReturnsMangasWithAnyGenre_WhenGenreMatchIsAny() {
+	tags := os.Getenv("POPULAR_MANGA_TAGS")
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dpm := getFakePopularManga()
+	dpm.Genre = "Action, Adventure"
+	dlm := getFakeLatestManga()
+	dlm.Genre = "Fantasy, Action"
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dpm, dlm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
+	config.Load()
+	defer func() {
+		_ = os.Setenv("POPULAR_MANGA_TAGS", tags)
+		config.Load()
+	}()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest([]string{"adventure", "fantasy"}, "any"))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), dpm.TitleID, (*pMangas)[0].TitleID)
+	assert.Equal(s.T(), dlm.TitleID, (*lMangas)[0].TitleID)
+}
+
+func (s *MangaServiceTestSuite) TestGetMangas_ReturnsMangasWithAllGenres_WhenGenreMatchIsAll() {
+	tags := os.Getenv("POPULAR_MANGA_TAGS")
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dpm := getFakePopularManga()
+	dpm.Genre = "Action, Adventure"
+	dlm := getFakeLatestManga()
+	dlm.Genre = "Fantasy, Action"
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dpm, dlm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	_ = os.Setenv("POPULAR_MANGA_TAGS", "one_piece")
+	config.Load()
+	defer func() {
+		_ = os.Setenv("POPULAR_MANGA_TAGS", tags)
+		config.Load()
+	}()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	pMangas, lMangas, err := ms.GetMangas(context.Background(), contract.NewMangaListRequest([]string{"action,fantasy"}, "all"))
+
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), pMangas)
+	assert.Equal(s.T(), 1, len(*lMangas))
+	assert.Equal(s.T(), dlm.TitleID, (*lMangas)[0].TitleID)
+}
+
+func (s *MangaServiceTestSuite) TestGetGenres_ReturnsError_WhenCacheMissesAndClientReturnsError() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	s.mc.On("GetMangaList", context.Background()).Return(nil, errors.New("some error"))
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	genres, err := ms.GetGenres(context.Background())
+
+	assert.Nil(s.T(), genres)
+	assert.Equal(s.T(), mErr.NewGenericError().Error(), err.Error())
+}
+
+func (s *MangaServiceTestSuite) TestGetGenres_ReturnsError_WhenNoMangaHasGenres() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dm := getFakePopularManga()
+	dm.Genre = ""
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	genres, err := ms.GetGenres(context.Background())
+
+	assert.Nil(s.T(), genres)
+	assert.Equal(s.T(), mErr.NewNotFoundError("genre").Error(), err.Error())
+}
+
+func (s *MangaServiceTestSuite) TestGetGenres_ReturnsSortedGenresWithCounts() {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+
+	dpm := getFakePopularManga()
+	dpm.Genre = "Action, Adventure"
+	dlm := getFakeLatestManga()
+	dlm.Genre = "fantasy, action"
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{dpm, dlm}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+	}()
+
+	ms := NewMangaService(s.mc, mcm, s.cs, s.ws)
+	genres, err := ms.GetGenres(context.Background())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []contract.Genre{
+		{Name: "Action", MangaCount: 2},
+		{Name: "Adventure", MangaCount: 1},
+		{Name: "Fantasy", MangaCount: 1},
+	}, *genres)
+}
+
+func getNewestChapterRequest(titleID string) contract.ChapterRequest {
+	req := contract.NewChapterRequest(titleID)
+	req.Limit = 1
+	return req
+}
+
 func assertMappedManga(t *testing.T, dm domain.Manga, cm contract.Manga) {
 	assert.Equal(t, dm.Title, cm.Title)
 	assert.Equal(t, dm.TitleID, cm.TitleID)
 	assert.Equal(t, dm.IconURL, cm.IconURL)
 	assert.Equal(t, dm.LastChapter, cm.LastChapter)
 	assert.Equal(t, dm.Genre, cm.Genre)
+	assert.Equal(t, common.ParseGenres(dm.Genre), cm.Genres)
 	assert.Equal(t, dm.Alias, cm.Alias)
 	assert.Equal(t, dm.Author, cm.Author)
 	assert.Equal(t, dm.Status, cm.Status)
 	assert.Equal(t, dm.Summary, cm.Summary)
 }
+
+// ageCacheEntry rewrites the write times of a cached value as if it had been
+// written age ago.
+func ageCacheEntry(key string, age time.Duration, ttl config.CacheTTL) {
+	storedAt := time.Now().Add(-age)
+	times, _ := json.Marshal(map[string]time.Time{"stored_at": storedAt, "stale_at": storedAt.Add(ttl.Expiration)})
+	appcontext.GetRedisClient().Set("CacheTimes|"+key, times, ttl.HardExpiration)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bigscreen/mangindo-feeder/cache/manager"
+	"github.com/bigscreen/mangindo-feeder/client"
+	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/search"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, req contract.SearchRequest) (*[]contract.Manga, error)
+}
+
+type searchService struct {
+	mangaClient             client.MangaClient
+	mangaCacheManager       manager.MangaCacheManager
+	searchIndexCacheManager manager.SearchIndexCacheManager
+	workerService           WorkerService
+
+	mu    sync.RWMutex
+	index *search.Index
+}
+
+func (s *searchService) getLoadedIndex(version string) *search.Index {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.index != nil && s.index.Version == version {
+		return s.index
+	}
+	return nil
+}
+
+func (s *searchService) getCachedIndex(ctx context.Context) (*search.Index, error) {
+	version, err := s.searchIndexCacheManager.GetVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if idx := s.getLoadedIndex(version); idx != nil {
+		return idx, nil
+	}
+
+	idx, err := s.searchIndexCacheManager.GetCache(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.index = idx
+	s.mu.Unlock()
+
+	return idx, nil
+}
+
+func (s *searchService) getIndex(ctx context.Context) (*search.Index, error) {
+	idx, err := s.getCachedIndex(ctx)
+	if err == nil {
+		return idx, nil
+	}
+
+	ml, err := getMangaList(ctx, s.mangaClient, s.mangaCacheManager, s.workerService)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok, _ := s.searchIndexCacheManager.LockRefresh(ctx); ok {
+		err = s.workerService.SetSearchIndex()
+		if err != nil {
+			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetSearchIndexJob, err.Error())
+		}
+	}
+
+	return search.NewIndex("", ml.Mangas), nil
+}
+
+func (s *searchService) Search(ctx context.Context, req contract.SearchRequest) (*[]contract.Manga, error) {
+	idx, err := s.getIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mangas := []contract.Manga{}
+	for _, r := range idx.Search(req.Query, constants.MaxSearchResults) {
+		mangas = append(mangas, getMappedManga(r.Manga))
+	}
+
+	return &mangas, nil
+}
+
+func NewSearchService(mc client.MangaClient, mcm manager.MangaCacheManager, sicm manager.SearchIndexCacheManager, ws WorkerService) *searchService {
+	return &searchService{
+		mangaClient:             mc,
+		mangaCacheManager:       mcm,
+		searchIndexCacheManager: sicm,
+		workerService:           ws,
+	}
+}
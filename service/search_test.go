@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/cache"
+	"github.com/bigscreen/mangindo-feeder/cache/manager"
+	"github.com/bigscreen/mangindo-feeder/config"
+	"github.com/bigscreen/mangindo-feeder/contract"
+	"github.com/bigscreen/mangindo-feeder/domain"
+	mErr "github.com/bigscreen/mangindo-feeder/error"
+	"github.com/bigscreen/mangindo-feeder/logger"
+	"github.com/bigscreen/mangindo-feeder/mock"
+	"github.com/bigscreen/mangindo-feeder/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchServiceTestSuite struct {
+	suite.Suite
+	mca cache.MangaCache
+	sca cache.SearchIndexCache
+	mc  *mock.MangaClientMock
+	ws  *mock.WorkerServiceMock
+}
+
+func TestSearchServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchServiceTestSuite))
+}
+
+func (s *SearchServiceTestSuite) SetupSuite() {
+	config.Load()
+	appcontext.Initiate()
+	logger.SetupLogger()
+}
+
+func (s *SearchServiceTestSuite) SetupTest() {
+	s.mca = cache.NewMangaCache()
+	s.sca = cache.NewSearchIndexCache()
+	s.mc = &mock.MangaClientMock{}
+	s.ws = &mock.WorkerServiceMock{}
+}
+
+func (s *SearchServiceTestSuite) newSearchService() SearchService {
+	mcm := manager.NewMangaCacheManager(s.mc, s.mca)
+	sicm := manager.NewSearchIndexCacheManager(mcm, s.sca)
+	return NewSearchService(s.mc, mcm, sicm, s.ws)
+}
+
+func (s *SearchServiceTestSuite) TestSearch_ReturnsError_WhenCachesMissAndClientReturnsError() {
+	s.mc.On("GetMangaList", context.Background()).Return(nil, errors.New("some error"))
+
+	ss := s.newSearchService()
+	mangas, err := ss.Search(context.Background(), contract.NewSearchRequest("piece"))
+
+	assert.Nil(s.T(), mangas)
+	assert.Equal(s.T(), mErr.NewGenericError().Error(), err.Error())
+
+	s.mc.AssertExpectations(s.T())
+	s.ws.AssertNotCalled(s.T(), "SetSearchIndex")
+}
+
+func (s *SearchServiceTestSuite) TestSearch_ReturnsMangas_WhenIndexMissesAndMangaCacheHits() {
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakePopularManga(), getFakeLatestManga()}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+		appcontext.GetRedisClient().Del("RefreshLock|SearchIndexCache")
+	}()
+
+	s.ws.On("SetSearchIndex").Return(nil)
+
+	ss := s.newSearchService()
+	mangas, err := ss.Search(context.Background(), contract.NewSearchRequest("one pice"))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(*mangas))
+	assertMappedManga(s.T(), getFakePopularManga(), (*mangas)[0])
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.ws.AssertExpectations(s.T())
+}
+
+func (s *SearchServiceTestSuite) TestSearch_EnqueuesIndexRebuildOnce_WhenIndexMissesRepeatedly() {
+	mr := domain.MangaListResponse{Mangas: []domain.Manga{getFakePopularManga(), getFakeLatestManga()}}
+	cb, _ := json.Marshal(mr)
+	_ = s.mca.Set(context.Background(), string(cb))
+	defer func() {
+		_ = s.mca.Delete(context.Background())
+		appcontext.GetRedisClient().Del("RefreshLock|SearchIndexCache")
+	}()
+
+	s.ws.On("SetSearchIndex").Return(nil)
+
+	ss := s.newSearchService()
+	for i := 0; i < 3; i++ {
+		_, err := ss.Search(context.Background(), contract.NewSearchRequest("one pice"))
+		assert.Nil(s.T(), err)
+	}
+
+	s.ws.AssertNumberOfCalls(s.T(), "SetSearchIndex", 1)
+}
+
+func (s *SearchServiceTestSuite) TestSearch_ReturnsMangas_WhenIndexHits() {
+	idx := search.NewIndex("v1", []domain.Manga{getFakePopularManga(), getFakeLatestManga()})
+	ib, _ := json.Marshal(idx)
+	_ = s.sca.Set(context.Background(), idx.Version, string(ib))
+	defer func() {
+		_ = s.sca.Delete(context.Background())
+	}()
+
+	ss := s.newSearchService()
+	mangas, err := ss.Search(context.Background(), contract.NewSearchRequest("toshiaki"))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(*mangas))
+	assertMappedManga(s.T(), getFakeLatestManga(), (*mangas)[0])
+
+	s.mc.AssertNotCalled(s.T(), "GetMangaList", context.Background())
+	s.ws.AssertNotCalled(s.T(), "SetSearchIndex")
+}
+
+func (s *SearchServiceTestSuite) TestSearch_ReloadsIndex_WhenVersionChanges() {
+	idx := search.NewIndex("v1", []domain.Manga{getFakePopularManga()})
+	ib, _ := json.Marshal(idx)
+	_ = s.sca.Set(context.Background(), idx.Version, string(ib))
+	defer func() {
+		_ = s.sca.Delete(context.Background())
+	}()
+
+	ss := s.newSearchService()
+	mangas, _ := ss.Search(context.Background(), contract.NewSearchRequest("kagamigami"))
+	assert.Empty(s.T(), *mangas)
+
+	idx = search.NewIndex("v2", []domain.Manga{getFakePopularManga(), getFakeLatestManga()})
+	ib, _ = json.Marshal(idx)
+	_ = s.sca.Set(context.Background(), idx.Version, string(ib))
+
+	mangas, err := ss.Search(context.Background(), contract.NewSearchRequest("kagamigami"))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, len(*mangas))
+}
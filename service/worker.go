@@ -2,6 +2,7 @@ package service
 
 import (
 	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	mErr "github.com/bigscreen/mangindo-feeder/error"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/bigscreen/mangindo-feeder/worker/adapter"
@@ -11,14 +12,18 @@ type workerService struct {
 	adapter adapter.Worker
 }
 
+// WorkerService enqueues the cache jobs as unique jobs, so a job that is
+// already waiting in the queue with the same args is not enqueued again.
 type WorkerService interface {
 	SetMangaCache() error
 	SetChapterCache(titleID string) error
-	SetContentCache(titleID string, chapter float32) error
+	SetContentCache(titleID string, chapter domain.ChapterID) error
+	SetContentMeta(titleID string, chapter domain.ChapterID) error
+	SetSearchIndex() error
 }
 
 func (s *workerService) SetMangaCache() error {
-	err := s.adapter.Perform(adapter.Job{
+	err := s.adapter.PerformUnique(adapter.Job{
 		Queue:   constants.WorkerDefaultQueue,
 		Handler: constants.SetMangaCacheJob,
 	})
@@ -31,7 +36,7 @@ func (s *workerService) SetMangaCache() error {
 }
 
 func (s *workerService) SetChapterCache(titleID string) error {
-	err := s.adapter.Perform(adapter.Job{
+	err := s.adapter.PerformUnique(adapter.Job{
 		Queue:   constants.WorkerDefaultQueue,
 		Handler: constants.SetChapterCacheJob,
 		Args: adapter.Args{
@@ -46,13 +51,13 @@ func (s *workerService) SetChapterCache(titleID string) error {
 	return nil
 }
 
-func (s *workerService) SetContentCache(titleID string, chapter float32) error {
-	err := s.adapter.Perform(adapter.Job{
+func (s *workerService) SetContentCache(titleID string, chapter domain.ChapterID) error {
+	err := s.adapter.PerformUnique(adapter.Job{
 		Queue:   constants.WorkerDefaultQueue,
 		Handler: constants.SetContentCacheJob,
 		Args: adapter.Args{
 			constants.JobArgTitleID: titleID,
-			constants.JobArgChapter: chapter,
+			constants.JobArgChapter: chapter.String(),
 		},
 	})
 	if err != nil {
@@ -63,6 +68,36 @@ func (s *workerService) SetContentCache(titleID string, chapter float32) error {
 	return nil
 }
 
+func (s *workerService) SetContentMeta(titleID string, chapter domain.ChapterID) error {
+	err := s.adapter.PerformUnique(adapter.Job{
+		Queue:   constants.WorkerDefaultQueue,
+		Handler: constants.SetContentMetaJob,
+		Args: adapter.Args{
+			constants.JobArgTitleID: titleID,
+			constants.JobArgChapter: chapter.String(),
+		},
+	})
+	if err != nil {
+		logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetContentMetaJob, err.Error())
+		return mErr.NewWorkerError(err.Error())
+	}
+
+	return nil
+}
+
+func (s *workerService) SetSearchIndex() error {
+	err := s.adapter.PerformUnique(adapter.Job{
+		Queue:   constants.WorkerDefaultQueue,
+		Handler: constants.SetSearchIndexJob,
+	})
+	if err != nil {
+		logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetSearchIndexJob, err.Error())
+		return mErr.NewWorkerError(err.Error())
+	}
+
+	return nil
+}
+
 func NewWorkerService(adapter adapter.Worker) *workerService {
 	return &workerService{
 		adapter: adapter,
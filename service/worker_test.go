@@ -7,6 +7,7 @@ import (
 	"github.com/bigscreen/mangindo-feeder/appcontext"
 	"github.com/bigscreen/mangindo-feeder/config"
 	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	mMock "github.com/bigscreen/mangindo-feeder/mock"
 	"github.com/bigscreen/mangindo-feeder/worker/adapter"
@@ -71,43 +72,89 @@ func (s *WorkerServiceTestSuite) TestSetChapterCache_ReturnsError_WhenItFails()
 
 func (s *WorkerServiceTestSuite) TestSetContentCache_ReturnsNil_WhenItSucceeds() {
 	w := &mMock.WorkerAdapterMock{}
-	stubSetContentJob(w, "bleach", float32(650), nil)
+	stubSetContentJob(w, "bleach", domain.ChapterID("650"), nil)
 
 	ws := NewWorkerService(w)
-	err := ws.SetContentCache("bleach", float32(650))
+	err := ws.SetContentCache("bleach", domain.ChapterID("650"))
 	assert.Nil(s.T(), err)
 	w.AssertExpectations(s.T())
 }
 
 func (s *WorkerServiceTestSuite) TestSetContentCache_ReturnsError_WhenItFails() {
 	w := &mMock.WorkerAdapterMock{}
-	stubSetContentJob(w, "bleach", float32(650), errors.New("some error"))
+	stubSetContentJob(w, "bleach", domain.ChapterID("650"), errors.New("some error"))
 
 	ws := NewWorkerService(w)
-	err := ws.SetContentCache("bleach", float32(650))
+	err := ws.SetContentCache("bleach", domain.ChapterID("650"))
+	assert.NotNil(s.T(), err)
+	w.AssertExpectations(s.T())
+}
+
+func (s *WorkerServiceTestSuite) TestSetContentMeta_ReturnsNil_WhenItSucceeds() {
+	w := &mMock.WorkerAdapterMock{}
+	stubWorkerPerformUnique(w, constants.SetContentMetaJob, adapter.Args{
+		constants.JobArgTitleID: "bleach",
+		constants.JobArgChapter: "650",
+	}).Return(nil)
+
+	ws := NewWorkerService(w)
+	err := ws.SetContentMeta("bleach", domain.ChapterID("650"))
+	assert.Nil(s.T(), err)
+	w.AssertExpectations(s.T())
+}
+
+func (s *WorkerServiceTestSuite) TestSetContentMeta_ReturnsError_WhenItFails() {
+	w := &mMock.WorkerAdapterMock{}
+	stubWorkerPerformUnique(w, constants.SetContentMetaJob, adapter.Args{
+		constants.JobArgTitleID: "bleach",
+		constants.JobArgChapter: "650",
+	}).Return(errors.New("some error"))
+
+	ws := NewWorkerService(w)
+	err := ws.SetContentMeta("bleach", domain.ChapterID("650"))
+	assert.NotNil(s.T(), err)
+	w.AssertExpectations(s.T())
+}
+
+func (s *WorkerServiceTestSuite) TestSetSearchIndex_ReturnsNil_WhenItSucceeds() {
+	w := &mMock.WorkerAdapterMock{}
+	stubWorkerPerformUnique(w, constants.SetSearchIndexJob, nil).Return(nil)
+
+	ws := NewWorkerService(w)
+	err := ws.SetSearchIndex()
+	assert.Nil(s.T(), err)
+	w.AssertExpectations(s.T())
+}
+
+func (s *WorkerServiceTestSuite) TestSetSearchIndex_ReturnsError_WhenItFails() {
+	w := &mMock.WorkerAdapterMock{}
+	stubWorkerPerformUnique(w, constants.SetSearchIndexJob, nil).Return(errors.New("some error"))
+
+	ws := NewWorkerService(w)
+	err := ws.SetSearchIndex()
 	assert.NotNil(s.T(), err)
 	w.AssertExpectations(s.T())
 }
 
 func stubSetMangaJob(w *mMock.WorkerAdapterMock, returnedErr error) {
-	stubWorkerPerform(w, constants.SetMangaCacheJob, nil).Return(returnedErr)
+	stubWorkerPerformUnique(w, constants.SetMangaCacheJob, nil).Return(returnedErr)
 }
 
 func stubSetChapterJob(w *mMock.WorkerAdapterMock, titleID string, returnedErr error) {
-	stubWorkerPerform(w, constants.SetChapterCacheJob, adapter.Args{
+	stubWorkerPerformUnique(w, constants.SetChapterCacheJob, adapter.Args{
 		constants.JobArgTitleID: titleID,
 	}).Return(returnedErr)
 }
 
-func stubSetContentJob(w *mMock.WorkerAdapterMock, titleID string, chapter float32, returnedErr error) {
-	stubWorkerPerform(w, constants.SetContentCacheJob, adapter.Args{
+func stubSetContentJob(w *mMock.WorkerAdapterMock, titleID string, chapter domain.ChapterID, returnedErr error) {
+	stubWorkerPerformUnique(w, constants.SetContentCacheJob, adapter.Args{
 		constants.JobArgTitleID: titleID,
-		constants.JobArgChapter: chapter,
+		constants.JobArgChapter: chapter.String(),
 	}).Return(returnedErr)
 }
 
-func stubWorkerPerform(w *mMock.WorkerAdapterMock, handlerName string, args adapter.Args) *mock.Call {
-	return w.On("Perform", adapter.Job{
+func stubWorkerPerformUnique(w *mMock.WorkerAdapterMock, handlerName string, args adapter.Args) *mock.Call {
+	return w.On("PerformUnique", adapter.Job{
 		Queue:   constants.WorkerDefaultQueue,
 		Handler: handlerName,
 		Args:    args,
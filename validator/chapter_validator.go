@@ -0,0 +1,29 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bigscreen/mangindo-feeder/domain"
+)
+
+type ChapterValidator struct {
+	Field string
+	Value *string
+}
+
+func (v ChapterValidator) Validate() (bool, string) {
+	if v.Value == nil || strings.TrimSpace(*v.Value) == "" {
+		return false, fmt.Sprintf("%s cannot be blank", v.Field)
+	}
+
+	if _, err := domain.ParseChapterID(*v.Value); err != nil {
+		return false, fmt.Sprintf("%s must be a chapter number", v.Field)
+	}
+
+	return true, ""
+}
+
+func (v ChapterValidator) FieldName() string {
+	return v.Field
+}
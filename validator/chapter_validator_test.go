@@ -0,0 +1,55 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChapterValidatorTestSuite struct {
+	suite.Suite
+}
+
+func TestChapterValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(ChapterValidatorTestSuite))
+}
+
+func (s *ChapterValidatorTestSuite) TestValidate_ReturnsFalse_WhenFieldIsMissing() {
+	validator := ChapterValidator{Field: "foo", Value: nil}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo cannot be blank", err)
+}
+
+func (s *ChapterValidatorTestSuite) TestValidate_ReturnsFalse_WhenFieldIsBlank() {
+	value := " "
+	validator := ChapterValidator{Field: "foo", Value: &value}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo cannot be blank", err)
+}
+
+func (s *ChapterValidatorTestSuite) TestValidate_ReturnsFalse_WhenChapterIsMalformed() {
+	for _, value := range []string{"abc", "1.", "-1", "1.2.3", "10a1"} {
+		value := value
+		validator := ChapterValidator{Field: "foo", Value: &value}
+		valid, err := validator.Validate()
+
+		assert.False(s.T(), valid, value)
+		assert.Equal(s.T(), "foo must be a chapter number", err)
+	}
+}
+
+func (s *ChapterValidatorTestSuite) TestValidate_ReturnsTrue_WhenChapterIsValid() {
+	for _, value := range []string{"657", "1001.55", "10a"} {
+		value := value
+		validator := ChapterValidator{Field: "foo", Value: &value}
+		valid, err := validator.Validate()
+
+		assert.True(s.T(), valid, value)
+		assert.Empty(s.T(), err)
+	}
+}
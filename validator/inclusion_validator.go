@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+type InclusionValidator struct {
+	Field   string
+	Value   *string
+	Options []string
+}
+
+func (v InclusionValidator) Validate() (bool, string) {
+	if v.Value == nil {
+		return false, fmt.Sprintf("%s cannot be blank", v.Field)
+	}
+
+	for _, option := range v.Options {
+		if *v.Value == option {
+			return true, ""
+		}
+	}
+
+	return false, fmt.Sprintf("%s must be one of %s", v.Field, strings.Join(v.Options, ", "))
+}
+
+func (v InclusionValidator) FieldName() string {
+	return v.Field
+}
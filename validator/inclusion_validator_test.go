@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type InclusionValidatorTestSuite struct {
+	suite.Suite
+}
+
+func TestInclusionValidatorTestSuite(t *testing.T) {
+	suite.Run(t, new(InclusionValidatorTestSuite))
+}
+
+func (s *InclusionValidatorTestSuite) TestValidate_ReturnsFalse_WhenFieldIsMissing() {
+	validator := InclusionValidator{Field: "foo", Value: nil, Options: []string{"asc", "desc"}}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo cannot be blank", err)
+}
+
+func (s *InclusionValidatorTestSuite) TestValidate_ReturnsFalse_WhenValueIsNotAnOption() {
+	value := "up"
+	validator := InclusionValidator{Field: "foo", Value: &value, Options: []string{"asc", "desc"}}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo must be one of asc, desc", err)
+}
+
+func (s *InclusionValidatorTestSuite) TestValidate_ReturnsTrue_WhenValueIsAnOption() {
+	value := "desc"
+	validator := InclusionValidator{Field: "foo", Value: &value, Options: []string{"asc", "desc"}}
+	valid, err := validator.Validate()
+
+	assert.True(s.T(), valid)
+	assert.Empty(s.T(), err)
+}
@@ -6,12 +6,18 @@ import (
 	"strings"
 )
 
+// NumberValidator accepts decimal numbers, or only whole numbers when
+// Integer is set.
 type NumberValidator struct {
-	Field string
-	Value *string
+	Field   string
+	Value   *string
+	Integer bool
 }
 
-const regexNumber = `\d+\.?\d*`
+var (
+	numberRegex  = regexp.MustCompile(`^\d+\.?\d*$`)
+	integerRegex = regexp.MustCompile(`^\d+$`)
+)
 
 func (v NumberValidator) Validate() (bool, string) {
 	if v.Value == nil {
@@ -24,8 +30,11 @@ func (v NumberValidator) Validate() (bool, string) {
 		return false, fmt.Sprintf("%s cannot be blank", v.Field)
 	}
 
-	regex := regexp.MustCompile(regexNumber)
-	if !regex.MatchString(*v.Value) {
+	if v.Integer {
+		if !integerRegex.MatchString(latLng) {
+			return false, fmt.Sprintf("%s must be a whole number", v.Field)
+		}
+	} else if !numberRegex.MatchString(latLng) {
 		return false, fmt.Sprintf("%s must be a number", v.Field)
 	}
 
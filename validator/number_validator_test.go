@@ -61,3 +61,30 @@ func (s *NumberValidatorTestSuite) TestValidate_ReturnsTrue_WhenFieldIsNonDecima
 	assert.True(s.T(), valid)
 	assert.Empty(s.T(), err)
 }
+
+func (s *NumberValidatorTestSuite) TestValidate_ReturnsFalse_WhenNumberIsSurroundedByOtherCharacters() {
+	value := "abc5"
+	validator := NumberValidator{Field: "foo", Value: &value}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo must be a number", err)
+}
+
+func (s *NumberValidatorTestSuite) TestValidate_ReturnsFalse_WhenIntegerIsDecimal() {
+	value := "1.5"
+	validator := NumberValidator{Field: "foo", Value: &value, Integer: true}
+	valid, err := validator.Validate()
+
+	assert.False(s.T(), valid)
+	assert.Equal(s.T(), "foo must be a whole number", err)
+}
+
+func (s *NumberValidatorTestSuite) TestValidate_ReturnsTrue_WhenIntegerIsWholeNumber() {
+	value := "15"
+	validator := NumberValidator{Field: "foo", Value: &value, Integer: true}
+	valid, err := validator.Validate()
+
+	assert.True(s.T(), valid)
+	assert.Empty(s.T(), err)
+}
@@ -7,6 +7,7 @@ import (
 	"syscall"
 
 	"github.com/bigscreen/mangindo-feeder/appcontext"
+	"github.com/bigscreen/mangindo-feeder/cache"
 	"github.com/bigscreen/mangindo-feeder/constants"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/bigscreen/mangindo-feeder/service"
@@ -16,6 +17,7 @@ import (
 
 func Start() {
 	wd := service.InstantiateWorkerDependencies()
+	go cache.SubscribeInvalidations(context.Background())
 	wa := appcontext.GetWorkerAdapter()
 	InitWorkerHandler(wa, wd)
 	err := wa.Start(context.Background())
@@ -1,9 +1,12 @@
 package worker
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/bigscreen/mangindo-feeder/constants"
+	"github.com/bigscreen/mangindo-feeder/domain"
 	"github.com/bigscreen/mangindo-feeder/logger"
 	"github.com/bigscreen/mangindo-feeder/service"
 	"github.com/bigscreen/mangindo-feeder/worker/adapter"
@@ -13,11 +16,21 @@ func InitWorkerHandler(w adapter.Worker, d service.WorkerDependencies) {
 	registerSetMangaCacheJob(w, d)
 	registerSetChapterCacheJob(w, d)
 	registerSetContentCacheJob(w, d)
+	registerSetContentMetaJob(w, d)
+	registerSetSearchIndexJob(w, d)
 }
 
 func registerSetMangaCacheJob(w adapter.Worker, d service.WorkerDependencies) {
 	err := w.Register(constants.SetMangaCacheJob, func(args adapter.Args) error {
-		return d.MangaCacheManager.SetCache()
+		err := d.MangaCacheManager.SetCache(context.Background())
+		if err != nil {
+			return err
+		}
+		err = d.WorkerService.SetSearchIndex()
+		if err != nil {
+			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetSearchIndexJob, err.Error())
+		}
+		return nil
 	})
 	if err != nil {
 		logger.Errorf("Error while registering %s job, error: %s", constants.SetMangaCacheJob, err.Error())
@@ -30,7 +43,7 @@ func registerSetChapterCacheJob(w adapter.Worker, d service.WorkerDependencies)
 		if !ok {
 			return fmt.Errorf("can not get argument %s", constants.JobArgTitleID)
 		}
-		return d.ChapterCacheManager.SetCache(titleID)
+		return d.ChapterCacheManager.SetCache(context.Background(), titleID)
 	})
 	if err != nil {
 		logger.Errorf("Error while registering %s job, error: %s", constants.SetChapterCacheJob, err.Error())
@@ -43,13 +56,71 @@ func registerSetContentCacheJob(w adapter.Worker, d service.WorkerDependencies)
 		if !ok {
 			return fmt.Errorf("can not get argument %s", constants.JobArgTitleID)
 		}
-		chapter, ok := args[constants.JobArgChapter].(float64)
-		if !ok {
-			return fmt.Errorf("can not get argument %s", constants.JobArgChapter)
+		chapter, err := getChapterArg(args)
+		if err != nil {
+			return err
+		}
+		err = d.ContentCacheManager.SetCache(context.Background(), titleID, chapter)
+		if err != nil {
+			return err
+		}
+
+		// Page images of a chapter do not change once it is out, so refreshes
+		// of a chapter whose pages were already probed skip the probing.
+		if _, err := d.ContentMetaCacheManager.GetCache(context.Background(), titleID, chapter); err == nil {
+			return nil
+		}
+		err = d.WorkerService.SetContentMeta(titleID, chapter)
+		if err != nil {
+			logger.Errorf("Failed to enqueue %s job, with error: %s", constants.SetContentMetaJob, err.Error())
 		}
-		return d.ContentCacheManager.SetCache(titleID, float32(chapter))
+		return nil
 	})
 	if err != nil {
 		logger.Errorf("Error while registering %s job, error: %s", constants.SetContentCacheJob, err.Error())
 	}
 }
+
+func registerSetContentMetaJob(w adapter.Worker, d service.WorkerDependencies) {
+	err := w.Register(constants.SetContentMetaJob, func(args adapter.Args) error {
+		titleID, ok := args[constants.JobArgTitleID].(string)
+		if !ok {
+			return fmt.Errorf("can not get argument %s", constants.JobArgTitleID)
+		}
+		chapter, err := getChapterArg(args)
+		if err != nil {
+			return err
+		}
+		return d.ContentMetaCacheManager.SetCache(context.Background(), titleID, chapter)
+	})
+	if err != nil {
+		logger.Errorf("Error while registering %s job, error: %s", constants.SetContentMetaJob, err.Error())
+	}
+}
+
+// getChapterArg also accepts the float chapter args enqueued before chapters
+// were passed as strings.
+func getChapterArg(args adapter.Args) (domain.ChapterID, error) {
+	var value string
+	switch v := args[constants.JobArgChapter].(type) {
+	case string:
+		value = v
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 32)
+	}
+
+	chapter, err := domain.ParseChapterID(value)
+	if err != nil {
+		return "", fmt.Errorf("can not get argument %s", constants.JobArgChapter)
+	}
+	return chapter, nil
+}
+
+func registerSetSearchIndexJob(w adapter.Worker, d service.WorkerDependencies) {
+	err := w.Register(constants.SetSearchIndexJob, func(args adapter.Args) error {
+		return d.SearchIndexCacheManager.SetCache(context.Background())
+	})
+	if err != nil {
+		logger.Errorf("Error while registering %s job, error: %s", constants.SetSearchIndexJob, err.Error())
+	}
+}